@@ -2,64 +2,145 @@ package redis
 
 import (
 	"context"
-	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/bsm/redislock"
 	goredis "github.com/go-redis/redis"
-	"github.com/opentracing/opentracing-go"
 )
 
 // Client is a minimal set of functions a redis client must implement
 type Client interface {
+	Append(key, value string) *goredis.IntCmd
 	BLPop(timeout time.Duration, keys ...string) *goredis.StringSliceCmd
+	BRPop(timeout time.Duration, keys ...string) *goredis.StringSliceCmd
+	BRPopLPush(source, destination string, timeout time.Duration) *goredis.StringCmd
+	BZPopMax(timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd
+	BZPopMin(timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd
+	BitCount(key string, bitCount *goredis.BitCount) *goredis.IntCmd
+	BitField(key string, args ...interface{}) *goredis.SliceCmd
+	BitOpAnd(destKey string, keys ...string) *goredis.IntCmd
+	BitOpOr(destKey string, keys ...string) *goredis.IntCmd
+	BitPos(key string, bit int64, pos ...int64) *goredis.IntCmd
 	Close() error
 	Context() context.Context
+	Decr(key string) *goredis.IntCmd
+	DecrBy(key string, decrement int64) *goredis.IntCmd
 	Del(keys ...string) *goredis.IntCmd
 	Eval(script string, keys []string, args ...interface{}) *goredis.Cmd
 	EvalSha(sha1 string, keys []string, args ...interface{}) *goredis.Cmd
 	Exists(keys ...string) *goredis.IntCmd
+	Expire(key string, expiration time.Duration) *goredis.BoolCmd
+	ExpireAt(key string, tm time.Time) *goredis.BoolCmd
+	GeoAdd(key string, geoLocation ...*goredis.GeoLocation) *goredis.IntCmd
+	GeoDist(key string, member1, member2, unit string) *goredis.FloatCmd
+	GeoHash(key string, members ...string) *goredis.StringSliceCmd
+	GeoPos(key string, members ...string) *goredis.GeoPosCmd
+	GeoRadius(key string, longitude, latitude float64, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd
+	GeoRadiusByMember(key, member string, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd
 	Get(key string) *goredis.StringCmd
+	GetBit(key string, offset int64) *goredis.IntCmd
+	GetRange(key string, start, end int64) *goredis.StringCmd
+	GetSet(key string, value interface{}) *goredis.StringCmd
 	HDel(key string, fields ...string) *goredis.IntCmd
 	HGet(key, field string) *goredis.StringCmd
 	HGetAll(string) *goredis.StringStringMapCmd
 	HMGet(string, ...string) *goredis.SliceCmd
 	HMSet(string, map[string]interface{}) *goredis.StatusCmd
+	HScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd
 	HSet(key, field string, value interface{}) *goredis.BoolCmd
+	Incr(key string) *goredis.IntCmd
+	IncrBy(key string, value int64) *goredis.IntCmd
+	LIndex(key string, index int64) *goredis.StringCmd
+	LLen(key string) *goredis.IntCmd
 	LPop(key string) *goredis.StringCmd
+	LPush(key string, values ...interface{}) *goredis.IntCmd
 	LRange(key string, start, stop int64) *goredis.StringSliceCmd
+	LRem(key string, count int64, value interface{}) *goredis.IntCmd
+	LTrim(key string, start, stop int64) *goredis.StatusCmd
 	MGet(keys ...string) *goredis.SliceCmd
 	MSet(pairs ...interface{}) *goredis.StatusCmd
 	Options() *goredis.Options
+	PExpire(key string, expiration time.Duration) *goredis.BoolCmd
+	PExpireAt(key string, tm time.Time) *goredis.BoolCmd
+	PFAdd(key string, els ...interface{}) *goredis.IntCmd
+	PFCount(keys ...string) *goredis.IntCmd
+	PFMerge(dest string, keys ...string) *goredis.StatusCmd
+	PSubscribeContext(ctx context.Context, patterns ...string) (*Subscription, error)
+	Persist(key string) *goredis.BoolCmd
 	Ping() *goredis.StatusCmd
+	Pipeline() goredis.Pipeliner
+	Pipelined(fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error)
+	Process(cmd goredis.Cmder) error
+	Publish(channel string, message interface{}) *goredis.IntCmd
+	RPop(key string) *goredis.StringCmd
 	RPopLPush(source string, destination string) *goredis.StringCmd
 	RPush(key string, values ...interface{}) *goredis.IntCmd
+	Rename(key, newkey string) *goredis.StatusCmd
 	SAdd(key string, members ...interface{}) *goredis.IntCmd
 	SCard(key string) *goredis.IntCmd
 	SIsMember(key string, member interface{}) *goredis.BoolCmd
 	SMembers(key string) *goredis.StringSliceCmd
 	SPopN(key string, count int64) *goredis.StringSliceCmd
 	SRem(key string, members ...interface{}) *goredis.IntCmd
+	SScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd
+	Scan(cursor uint64, match string, count int64) *goredis.ScanCmd
 	ScriptExists(scripts ...string) *goredis.BoolSliceCmd
 	ScriptLoad(script string) *goredis.StringCmd
 	Set(key string, value interface{}, expiration time.Duration) *goredis.StatusCmd
+	SetBit(key string, offset int64, value int) *goredis.IntCmd
 	SetNX(key string, value interface{}, expiration time.Duration) *goredis.BoolCmd
+	SetRange(key string, offset int64, value string) *goredis.IntCmd
+	StrLen(key string) *goredis.IntCmd
+	SubscribeContext(ctx context.Context, channels ...string) (*Subscription, error)
 	TTL(key string) *goredis.DurationCmd
 	PTTL(key string) *goredis.DurationCmd
 	TxPipeline() goredis.Pipeliner
+	TxPipelined(fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error)
+	Type(key string) *goredis.StatusCmd
+	Unlink(keys ...string) *goredis.IntCmd
+	Watch(fn func(*goredis.Tx) error, keys ...string) error
 	WithContext(context.Context) Client
+	XAck(stream, group string, ids ...string) *goredis.IntCmd
+	XAdd(a *goredis.XAddArgs) *goredis.StringCmd
+	XClaim(a *goredis.XClaimArgs) *goredis.XMessageSliceCmd
+	XClaimJustID(a *goredis.XClaimArgs) *goredis.StringSliceCmd
+	XDel(stream string, ids ...string) *goredis.IntCmd
+	XGroupCreate(stream, group, start string) *goredis.StatusCmd
+	XGroupCreateMkStream(stream, group, start string) *goredis.StatusCmd
+	XGroupDelConsumer(stream, group, consumer string) *goredis.IntCmd
+	XGroupDestroy(stream, group string) *goredis.IntCmd
+	XGroupSetID(stream, group, start string) *goredis.StatusCmd
+	XLen(stream string) *goredis.IntCmd
+	XPending(stream, group string) *goredis.XPendingCmd
+	XPendingExt(a *goredis.XPendingExtArgs) *goredis.XPendingExtCmd
+	XRange(stream, start, stop string) *goredis.XMessageSliceCmd
+	XRead(a *goredis.XReadArgs) *goredis.XStreamSliceCmd
+	XReadGroup(a *goredis.XReadGroupArgs) *goredis.XStreamSliceCmd
+	XTrim(key string, maxLen int64) *goredis.IntCmd
+	XTrimApprox(key string, maxLen int64) *goredis.IntCmd
 	ZAdd(key string, members ...goredis.Z) *goredis.IntCmd
 	ZCard(key string) *goredis.IntCmd
+	ZCount(key, min, max string) *goredis.IntCmd
+	ZIncrBy(key string, increment float64, member string) *goredis.FloatCmd
+	ZInterStore(destination string, store goredis.ZStore, keys ...string) *goredis.IntCmd
+	ZPopMax(key string, count ...int64) *goredis.ZSliceCmd
+	ZPopMin(key string, count ...int64) *goredis.ZSliceCmd
+	ZRange(key string, start, stop int64) *goredis.StringSliceCmd
 	ZRangeByScore(key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd
 	ZRangeByScoreWithScores(key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd
 	ZRangeWithScores(key string, start, stop int64) *goredis.ZSliceCmd
 	ZRank(key, member string) *goredis.IntCmd
 	ZRem(key string, members ...interface{}) *goredis.IntCmd
+	ZRemRangeByRank(key string, start, stop int64) *goredis.IntCmd
+	ZRemRangeByScore(key, min, max string) *goredis.IntCmd
 	ZRevRangeByScore(key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd
 	ZRevRangeByScoreWithScores(key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd
 	ZRevRangeWithScores(key string, start, stop int64) *goredis.ZSliceCmd
 	ZRevRank(key, member string) *goredis.IntCmd
+	ZScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd
 	ZScore(key, member string) *goredis.FloatCmd
+	ZUnionStore(dest string, store goredis.ZStore, keys ...string) *goredis.IntCmd
 }
 
 // LockerClient is a redis Client that has support for Locker operations
@@ -70,88 +151,234 @@ type LockerClient interface {
 }
 
 // BaseClient implements Client since it wraps a *goredis.Client
-// and Locker for distributed locking
-// it's WithContext calls WithContext in the underlying *goredis.Client
-// and instruments opentracing support through go-redis middlewares
+// and Locker for distributed locking. It's instrumented with tracing support
+// through go-redis middlewares when created, and it's WithContext calls WithContext
+// in the underlying *goredis.Client, tracing under the given context
 type BaseClient struct {
 	*goredis.Client
-	locker *redislock.Client
-	ctx    context.Context
+	lockClient
+	instrumentation *instrumentation
+	masterName      string
+	sentinelAddrs   []string
 }
 
-// NewClient creates a BaseClient instance with an underlying *goredis.Client
-// and a *redislock.Client
-func NewClient(opt *goredis.Options) (*BaseClient, error) {
-	conn, err := newClientConn(opt)
+// lockClient implements Locker over a go-redis client, it's shared by
+// BaseClient and ClusterClient. Locks are obtained under `ctx`
+type lockClient struct {
+	client      goredis.Cmdable
+	addr        string
+	db          int
+	locker      *redislock.Client
+	lockMetrics *atomic.Value
+	ctx         context.Context
+	// under returns a copy of the go-redis client tracing its commands under a context
+	under func(ctx context.Context) goredis.Cmdable
+}
+
+func newLockClient(
+	client goredis.Cmdable,
+	addr string,
+	db int,
+	under func(ctx context.Context) goredis.Cmdable,
+) lockClient {
+	return lockClient{
+		client:      client,
+		addr:        addr,
+		db:          db,
+		locker:      redislock.New(client),
+		lockMetrics: &atomic.Value{},
+		under:       under,
+	}
+}
+
+// withContext returns a copy of c obtaining locks under `ctx` through `client`
+func (c lockClient) withContext(ctx context.Context, client goredis.Cmdable) lockClient {
+	c.client = client
+	c.locker = redislock.New(client)
+	c.ctx = ctx
+	return c
+}
+
+// traced returns a copy of c obtaining locks under `ctx`, the context of a lock span,
+// through a client tracing their commands under it too, so they're children of the span
+func (c lockClient) traced(ctx context.Context) lockClient {
+	if ctx == nil || c.under == nil {
+		return c
+	}
+	return c.withContext(ctx, c.under(ctx))
+}
+
+// redisLockRelease is the release script of redislock, so its locks
+// can be released through a client other than the one that obtained them
+var redisLockRelease = goredis.NewScript(
+	`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`,
+)
+
+// redisLock is a *redislock.Lock that can be released through another client
+type redisLock struct {
+	*redislock.Lock
+}
+
+func (l redisLock) releaseThrough(client goredis.Cmdable) error {
+	res, err := redisLockRelease.Run(client, []string{l.Key()}, l.Token()+l.Metadata()).Result()
+	if err == goredis.Nil {
+		return ErrLockNotHeld
+	}
 	if err != nil {
+		return err
+	}
+	if i, ok := res.(int64); !ok || i != 1 {
+		return ErrLockNotHeld
+	}
+	return nil
+}
+
+// NewClient creates a BaseClient instance with an underlying *goredis.Client
+// and a *redislock.Client. It waits for the connection as set by `connect`,
+// by default pinging for up to opt.DialTimeout
+func NewClient(opt *goredis.Options, connect ...ConnectOption) (*BaseClient, error) {
+	conn := goredis.NewClient(opt)
+	client := newBaseClient(conn, conn.Options().Addr, opt.DB)
+	if err := waitConnection(client.Client, client.Options().DialTimeout, connect); err != nil {
+		client.Close()
 		return nil, err
 	}
-	locker := redislock.New(conn)
-	return &BaseClient{Client: conn, locker: locker}, nil
+	return client, nil
 }
 
-func newClientConn(opt *goredis.Options) (*goredis.Client, error) {
-	client := goredis.NewClient(opt)
-	if err := waitConnection(client); err != nil {
+// NewFailoverClient creates a BaseClient instance with an underlying *goredis.Client
+// connected to the master `opt.MasterName` through Redis Sentinel, and a *redislock.Client.
+// Since the master address changes on failover, Mux maps it by its master name
+// and sentinel addresses.
+// It waits for the connection like NewClient
+func NewFailoverClient(opt *goredis.FailoverOptions, connect ...ConnectOption) (*BaseClient, error) {
+	client := newBaseClient(goredis.NewFailoverClient(opt), opt.MasterName, opt.DB)
+	client.masterName = opt.MasterName
+	client.sentinelAddrs = opt.SentinelAddrs
+	if err := waitConnection(client.Client, client.Options().DialTimeout, connect); err != nil {
+		client.Close()
 		return nil, err
 	}
 	return client, nil
 }
 
+// newBaseClient instruments `conn`, a client to `addr`, and creates a BaseClient over it
+func newBaseClient(conn *goredis.Client, addr string, db int) *BaseClient {
+	in := instrument(conn, addr, db)
+	under := func(ctx context.Context) goredis.Cmdable {
+		conncpy := conn.WithContext(ctx)
+		in.withContext(conncpy, ctx)
+		return conncpy
+	}
+	return &BaseClient{
+		Client:          conn,
+		lockClient:      newLockClient(conn, addr, db, under),
+		instrumentation: in,
+	}
+}
+
+// MasterName returns the Sentinel master name of a client created by NewFailoverClient,
+// or an empty string otherwise
+func (c BaseClient) MasterName() string {
+	return c.masterName
+}
+
+// SentinelAddrs returns the Sentinel addresses of a client created by NewFailoverClient,
+// or nil otherwise
+func (c BaseClient) SentinelAddrs() []string {
+	return c.sentinelAddrs
+}
+
 // WithContext returns a new *BaseClient with *goredis.Client and *redislock.Client using ctx
 func (c *BaseClient) WithContext(ctx context.Context) Client {
 	conncpy := c.Client.WithContext(ctx)
-	ccpy := &BaseClient{Client: conncpy, locker: c.locker, ctx: ctx}
-	instrument(ccpy)
-	return ccpy
+	c.instrumentation.withContext(conncpy, ctx)
+	return &BaseClient{
+		Client:          conncpy,
+		lockClient:      c.lockClient.withContext(ctx, conncpy),
+		instrumentation: c.instrumentation,
+		masterName:      c.masterName,
+		sentinelAddrs:   c.sentinelAddrs,
+	}
+}
+
+// Watch runs `fn` in a transaction watching `keys`, like *goredis.Client.Watch,
+// with the transaction commands traced like the client's
+func (c BaseClient) Watch(fn func(*goredis.Tx) error, keys ...string) error {
+	return c.watchContext(c.Client.Context(), fn, keys...)
 }
 
 // Obtain tries to hold a lock over `key` during `ttl` duration. It also
-func (c BaseClient) Obtain(key string, ttl time.Duration, opt LockOptions) (Lock, error) {
-	tags := opentracing.Tags{
-		"db.instance": c.Client.Options().DB,
-		"db.type":     "redis",
-		"span.kind":   "client",
-	}
-	var lock Lock
-	err := trace(c.ctx, "redis obtain lock", tags, func() error {
-		var err error
-		lock, err = c.obtain(key, ttl, opt)
-		return err
+// traces the acquisition. If the client's context has a lock owner (see WithLockOwner)
+// the lock is reentrant for that owner, otherwise if opt.Fair is set, waiters obtain it in FIFO order
+func (c lockClient) Obtain(key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	return c.traceObtain(c.ctx, key, ttl, func(ctx context.Context, stats *lockStats) (Lock, error) {
+		return c.traced(ctx).obtain(key, ttl, opt, stats)
 	})
-	return lock, err
 }
 
-func (c BaseClient) obtain(key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+func (c lockClient) obtain(key string, ttl time.Duration, opt LockOptions, stats *lockStats) (Lock, error) {
+	if owner, ok := LockOwner(c.ctx); ok {
+		return c.obtainReentrant(owner, key, ttl, opt, stats)
+	}
+	if opt.Fair {
+		return c.obtainFair(c.ctx, key, ttl, time.Now().Add(ttl), stats)
+	}
 	rlopt := opt.toRedisLockOptions()
-	return c.locker.Obtain(key, ttl, &rlopt)
+	rlopt.Context = c.ctx
+	rlopt.RetryStrategy = countRetries(rlopt.RetryStrategy, stats)
+	lock, err := c.locker.Obtain(key, ttl, &rlopt)
+	if err != nil {
+		return nil, err
+	}
+	return redisLock{lock}, nil
 }
 
-func waitConnection(client *goredis.Client) error {
-	timeout := time.Now().Add(client.Options().DialTimeout)
-	ticker := time.NewTicker(10 * time.Millisecond)
-	defer ticker.Stop()
-	for range ticker.C {
-		if connected(client) {
-			return nil
-		}
-		if time.Now().After(timeout) {
-			return fmt.Errorf("timed out while waiting for Redis to connect")
+// obtainUnder is Obtain under `ctx` instead of the client's context
+func (c lockClient) obtainUnder(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	c.ctx = ctx
+	return c.Obtain(key, ttl, opt)
+}
+
+// ObtainContext tries to hold a lock over `key` during `ttl` duration, retrying with
+// a jittered exponential backoff from opt.MinTime to opt.MaxTime until it's obtained
+// or `ctx` is done, in which case ctx.Err() is returned. opt.Limit is ignored.
+// If `ctx` has a lock owner (see WithLockOwner) the lock is reentrant for that owner,
+// otherwise if opt.Fair is set, waiters obtain it in FIFO order
+func (c lockClient) ObtainContext(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	return c.traceObtain(ctx, key, ttl, func(spanCtx context.Context, stats *lockStats) (Lock, error) {
+		if spanCtx != nil {
+			ctx = spanCtx
 		}
-	}
-	return nil
+		return c.traced(spanCtx).obtainContext(ctx, key, ttl, opt, stats)
+	})
 }
 
-func connected(client *goredis.Client) bool {
-	result := client.Ping()
-	if result == nil {
-		return false
+func (c lockClient) obtainContext(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	opt LockOptions,
+	stats *lockStats,
+) (Lock, error) {
+	try := func() (Lock, error) {
+		lock, err := c.locker.Obtain(key, ttl, nil)
+		if err == ErrLockNotObtained {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return redisLock{lock}, nil
 	}
-	str, err := result.Result()
-	if err != nil {
-		return false
+	if owner, ok := LockOwner(ctx); ok {
+		try = func() (Lock, error) {
+			return c.tryObtainReentrant(owner, key, ttl)
+		}
+	} else if opt.Fair {
+		return c.obtainFair(ctx, key, ttl, time.Time{}, stats)
 	}
-	return str == "PONG"
+	return retryObtain(ctx, countRetries(opt.jitterRetryStrategy(), stats), time.Time{}, try)
 }
 
 var _ Client = (*BaseClient)(nil)
@@ -1,6 +1,9 @@
 package redis_test
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,4 +29,367 @@ func TestNewClient_Timeout(t *testing.T) {
 	assert.Nil(t, err)
 	_, err = redis.NewClient(opt)
 	assert.Error(t, err, "timed out while waiting for Redis to connect")
+	assert.True(t, errors.Is(err, redis.ErrConnectTimeout))
+}
+
+func TestNewClient_ConnectContext(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6660")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	var ready error
+	start := time.Now()
+	_, err = redis.NewClient(opt,
+		redis.WithConnectContext(ctx),
+		redis.WithConnectBackoff(time.Millisecond, 10*time.Millisecond),
+		redis.WithReadyCallback(func(err error) { ready = err }),
+	)
+	assert.True(t, errors.Is(err, redis.ErrConnectTimeout))
+	assert.Equal(t, err, ready)
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+}
+
+func TestNewClient_LazyConnect(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6660")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ready := make(chan error, 1)
+	client, err := redis.NewClient(opt,
+		redis.WithLazyConnect(),
+		redis.WithConnectContext(ctx),
+		redis.WithReadyCallback(func(err error) { ready <- err }),
+	)
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+	assert.True(t, errors.Is(<-ready, redis.ErrConnectTimeout))
+	opt, err = goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	_, err = redis.NewClient(opt,
+		redis.WithLazyConnect(),
+		redis.WithReadyCallback(func(err error) { ready <- err }),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, <-ready)
+}
+
+func TestNewClient_LazyConnectClosed(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6660")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	ready := make(chan error, 1)
+	client, err := redis.NewClient(opt,
+		redis.WithLazyConnect(),
+		redis.WithConnectBackoff(time.Millisecond, time.Millisecond),
+		redis.WithReadyCallback(func(err error) { ready <- err }),
+	)
+	assert.Nil(t, err)
+	// closed clients never reconnect, so the wait is over
+	assert.Nil(t, client.Close())
+	select {
+	case err := <-ready:
+		assert.True(t, errors.Is(err, redis.ErrConnectTimeout))
+	case <-time.After(time.Second):
+		t.Fatal("still waiting for a closed client to connect")
+	}
+}
+
+func TestClient_ObtainReentrant(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	lockOpt := redis.LockOptions{MinTime: 16 * time.Millisecond, MaxTime: 16 * time.Millisecond, Limit: 1}
+	outer, err := client.ObtainReentrant("owner-a", "some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	inner, err := client.ObtainReentrant("owner-a", "some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	_, err = client.ObtainReentrant("owner-b", "some_key", time.Second, lockOpt)
+	assert.Error(t, err)
+	assert.Nil(t, inner.Release())
+	_, err = client.ObtainReentrant("owner-b", "some_key", time.Second, lockOpt)
+	assert.Error(t, err)
+	assert.Nil(t, outer.Release())
+	assert.Error(t, outer.Release())
+	other, err := client.ObtainReentrant("owner-b", "some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	assert.Nil(t, other.Release())
+}
+
+func TestClient_ObtainContext(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	lockOpt := redis.DefaultLockOptions()
+	lock, err := client.ObtainContext(context.Background(), "some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	// contention with a bounded retry
+	_, err = client.Obtain("some_key", time.Second, lockOpt)
+	assert.Equal(t, redis.ErrLockNotObtained, err)
+	// contention until the context deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ObtainContext(ctx, "some_key", time.Second, lockOpt)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	// waits until the lock is released
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		lock.Release()
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	lock, err = client.ObtainContext(ctx, "some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+}
+
+func TestClient_ObtainFair(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	lockOpt := redis.LockOptions{Fair: true}
+	// bounded by the context while the lock is held
+	lock, err := client.Obtain("some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ObtainContext(ctx, "some_key", time.Second, lockOpt)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	// the waiter doesn't keep blocking a connection
+	stats := client.PoolStats()
+	assert.Equal(t, stats.TotalConns, stats.IdleConns)
+	assert.Nil(t, lock.Release())
+}
+
+type lockMetricsRecorder struct {
+	waits   []error
+	retries []int
+	expired []bool
+}
+
+func (r *lockMetricsRecorder) ObserveLockWait(key string, wait time.Duration, retries int, err error) {
+	r.waits = append(r.waits, err)
+	r.retries = append(r.retries, retries)
+}
+
+func (r *lockMetricsRecorder) ObserveLockHold(key string, hold time.Duration, expired bool) {
+	r.expired = append(r.expired, expired)
+}
+
+func TestClient_LockMetrics(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	metrics := &lockMetricsRecorder{}
+	client.SetLockMetrics(metrics)
+	lockOpt := redis.DefaultLockOptions()
+	lock, err := client.Obtain("some_key", 20*time.Millisecond, lockOpt)
+	assert.Nil(t, err)
+	_, err = client.Obtain("some_key", 20*time.Millisecond, redis.LockOptions{MinTime: time.Millisecond, MaxTime: time.Millisecond, Limit: 2})
+	assert.Equal(t, redis.ErrLockNotObtained, err)
+	// held longer than its TTL
+	time.Sleep(30 * time.Millisecond)
+	lock.Release()
+	lock, err = client.WithContext(context.Background()).(*redis.BaseClient).
+		Obtain("some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+	assert.Equal(t, []error{nil, redis.ErrLockNotObtained, nil}, metrics.waits)
+	assert.Equal(t, []int{0, 2, 0}, metrics.retries)
+	assert.Equal(t, []bool{true, false}, metrics.expired)
+}
+
+func TestClient_SetLockMetricsWhileObtaining(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	c := client.WithContext(context.Background()).(*redis.BaseClient)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			lock, err := c.Obtain("some_key", time.Second, redis.DefaultLockOptions())
+			if assert.Nil(t, err) {
+				assert.Nil(t, lock.Release())
+			}
+		}
+	}()
+	metrics := &lockMetricsRecorder{}
+	client.SetLockMetrics(metrics)
+	<-done
+	// copies returned before share it too
+	lock, err := c.Obtain("some_key", time.Second, redis.DefaultLockOptions())
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+	assert.Contains(t, metrics.expired, false)
+}
+
+func TestClient_PipelinedAndWatch(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	var c redis.Client = client
+	cmds, err := c.Pipelined(func(pipe goredis.Pipeliner) error {
+		pipe.Set("some_key", "1", 0)
+		pipe.Get("some_key")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, cmds, 2)
+	err = c.Watch(func(tx *goredis.Tx) error {
+		value, err := tx.Get("some_key").Int64()
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(func(pipe goredis.Pipeliner) error {
+			pipe.Set("some_key", value+1, 0)
+			return nil
+		})
+		return err
+	}, "some_key")
+	assert.Nil(t, err)
+	value, err := c.Get("some_key").Int64()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), value)
+	errCli := redis.NewErrClient(redis.ErrShardUnknown)
+	_, err = errCli.TxPipelined(func(goredis.Pipeliner) error { return nil })
+	assert.Equal(t, redis.ErrShardUnknown, err)
+	assert.Equal(t, redis.ErrShardUnknown, errCli.Watch(func(*goredis.Tx) error { return nil }))
+}
+
+func TestErrClient_Pipeline(t *testing.T) {
+	errCli := redis.NewErrClient(redis.ErrShardUnknown)
+	for _, pipe := range []goredis.Pipeliner{errCli.Pipeline(), errCli.TxPipeline()} {
+		if assert.NotNil(t, pipe) {
+			// queued commands fail with the ErrClient's error, without connecting anywhere
+			assert.Equal(t, redis.ErrShardUnknown, pipe.Set("key", "value", 0).Err())
+			assert.Equal(t, redis.ErrShardUnknown, pipe.Get("key").Err())
+			assert.Equal(t, redis.ErrShardUnknown, pipe.GeoPos("key", "member").Err())
+			assert.Equal(t, redis.ErrShardUnknown, pipe.Process(goredis.NewCmd("ping")))
+			_, err := pipe.Exec()
+			assert.Equal(t, redis.ErrShardUnknown, err)
+		}
+	}
+	assert.Equal(t, redis.ErrShardUnknown, errCli.GeoPos("key", "member").Err())
+}
+
+func TestErrClient_ConcurrentErrors(t *testing.T) {
+	errs := []error{errors.New("first"), errors.New("second")}
+	var wg sync.WaitGroup
+	for _, err := range errs {
+		wg.Add(1)
+		go func(err error) {
+			defer wg.Done()
+			errCli := redis.NewErrClient(err)
+			for i := 0; i < 100; i++ {
+				assert.Equal(t, err, errCli.XRange("stream", "-", "+").Err())
+				assert.Equal(t, err, errCli.Process(goredis.NewCmd("ping")))
+			}
+		}(err)
+	}
+	wg.Wait()
+}
+
+func TestClient_CountersAndExpiry(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	var c redis.Client = client
+	assert.Equal(t, int64(1), c.Incr("counter").Val())
+	assert.Equal(t, int64(5), c.IncrBy("counter", 4).Val())
+	assert.Equal(t, int64(4), c.Decr("counter").Val())
+	assert.Equal(t, "string", c.Type("counter").Val())
+	assert.True(t, c.Expire("counter", time.Minute).Val())
+	assert.True(t, c.Persist("counter").Val())
+	assert.Nil(t, c.Rename("counter", "other_counter").Err())
+	assert.Equal(t, int64(1), c.StrLen("other_counter").Val())
+	assert.Equal(t, int64(1), c.Unlink("other_counter").Val())
+	var errCli redis.Client = redis.NewErrClient(redis.ErrShardUnknown)
+	assert.Equal(t, redis.ErrShardUnknown, errCli.Incr("counter").Err())
+	assert.Equal(t, redis.ErrShardUnknown, errCli.Expire("counter", time.Minute).Err())
+}
+
+func TestClient_GeoHyperLogLogAndBitmaps(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	var c redis.Client = client
+	added, err := c.GeoAdd("regions",
+		&goredis.GeoLocation{Name: "rio", Longitude: -43.1729, Latitude: -22.9068},
+		&goredis.GeoLocation{Name: "sao_paulo", Longitude: -46.6333, Latitude: -23.5505},
+	).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), added)
+	dist, err := c.GeoDist("regions", "rio", "sao_paulo", "km").Result()
+	assert.Nil(t, err)
+	assert.InDelta(t, 360, dist, 10)
+	assert.Nil(t, c.PFAdd("players", "a", "b", "a").Err())
+	assert.Equal(t, int64(2), c.PFCount("players").Val())
+	assert.Nil(t, c.SetBit("active", 7, 1).Err())
+	assert.Equal(t, int64(1), c.GetBit("active", 7).Val())
+	assert.Equal(t, int64(1), c.BitCount("active", nil).Val())
+	var errCli redis.Client = redis.NewErrClient(redis.ErrShardUnknown)
+	assert.Equal(t, redis.ErrShardUnknown, errCli.GeoPos("regions", "rio").Err())
+	assert.Equal(t, redis.ErrShardUnknown, errCli.BitField("counters", "GET", "u8", 0).Err())
+}
+
+func TestClient_BitField(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	values, err := client.BitField("counters", "INCRBY", "u8", 0, 3, "GET", "u8", 0).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{int64(3), int64(3)}, values)
+}
+
+func TestClient_SortedSetsAndLists(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	var c redis.Client = client
+	assert.Nil(t, c.ZAdd("ranking", goredis.Z{Score: 1, Member: "a"}, goredis.Z{Score: 2, Member: "b"}).Err())
+	assert.Equal(t, float64(4), c.ZIncrBy("ranking", 3, "a").Val())
+	assert.Equal(t, []string{"b", "a"}, c.ZRange("ranking", 0, -1).Val())
+	assert.Equal(t, int64(1), c.ZCount("ranking", "3", "+inf").Val())
+	assert.Equal(t, []goredis.Z{{Score: 4, Member: "a"}}, c.ZPopMax("ranking").Val())
+	assert.Equal(t, int64(1), c.ZRemRangeByRank("ranking", 0, 0).Val())
+	assert.Equal(t, int64(2), c.LPush("queue", "a", "b").Val())
+	assert.Equal(t, int64(2), c.LLen("queue").Val())
+	assert.Equal(t, "b", c.LIndex("queue", 0).Val())
+	assert.Equal(t, "a", c.RPop("queue").Val())
+	assert.Equal(t, []string{"queue", "b"}, c.BRPop(time.Second, "queue").Val())
+	var errCli redis.Client = redis.NewErrClient(redis.ErrShardUnknown)
+	assert.Equal(t, redis.ErrShardUnknown, errCli.ZPopMin("ranking").Err())
+	assert.Equal(t, redis.ErrShardUnknown, errCli.BZPopMin(time.Second, "ranking").Err())
+	assert.Equal(t, redis.ErrShardUnknown, errCli.LTrim("queue", 0, 1).Err())
 }
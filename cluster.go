@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"context"
+	"strings"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// ClusterClient implements Client since it wraps a *goredis.ClusterClient
+// and Locker for distributed locking. Its locks only use keys in the hash slot
+// of the locked key, so they're held by the node serving that slot.
+// It's WithContext calls WithContext in the underlying *goredis.ClusterClient
+// and instruments tracing support through go-redis middlewares
+type ClusterClient struct {
+	*goredis.ClusterClient
+	lockClient
+	instrumentation *instrumentation
+}
+
+// NewClusterClient creates a ClusterClient instance with an underlying
+// *goredis.ClusterClient and a *redislock.Client. It waits for the connection like NewClient
+func NewClusterClient(opt *goredis.ClusterOptions, connect ...ConnectOption) (*ClusterClient, error) {
+	conn := goredis.NewClusterClient(opt)
+	addrs := strings.Join(opt.Addrs, ",")
+	in := instrument(conn, addrs, 0)
+	under := func(ctx context.Context) goredis.Cmdable {
+		conncpy := conn.WithContext(ctx)
+		in.withContext(conncpy, ctx)
+		return conncpy
+	}
+	client := &ClusterClient{
+		ClusterClient:   conn,
+		lockClient:      newLockClient(conn, addrs, 0, under),
+		instrumentation: in,
+	}
+	if err := waitConnection(conn, conn.Options().DialTimeout, connect); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// WithContext returns a new *ClusterClient with *goredis.ClusterClient and *redislock.Client using ctx
+func (c *ClusterClient) WithContext(ctx context.Context) Client {
+	conncpy := c.ClusterClient.WithContext(ctx)
+	c.instrumentation.withContext(conncpy, ctx)
+	return &ClusterClient{
+		ClusterClient:   conncpy,
+		lockClient:      c.lockClient.withContext(ctx, conncpy),
+		instrumentation: c.instrumentation,
+	}
+}
+
+// Options returns *goredis.Options with the settings of the cluster nodes connections.
+// Its Addr, by which a Mux maps the ClusterClient, lists all the cluster seed addresses.
+// Use ClusterOptions for the options the ClusterClient was created with
+func (c ClusterClient) Options() *goredis.Options {
+	opt := c.ClusterOptions()
+	return &goredis.Options{
+		Addr:               strings.Join(opt.Addrs, ","),
+		Password:           opt.Password,
+		MaxRetries:         opt.MaxRetries,
+		MinRetryBackoff:    opt.MinRetryBackoff,
+		MaxRetryBackoff:    opt.MaxRetryBackoff,
+		DialTimeout:        opt.DialTimeout,
+		ReadTimeout:        opt.ReadTimeout,
+		WriteTimeout:       opt.WriteTimeout,
+		PoolSize:           opt.PoolSize,
+		MinIdleConns:       opt.MinIdleConns,
+		MaxConnAge:         opt.MaxConnAge,
+		PoolTimeout:        opt.PoolTimeout,
+		IdleTimeout:        opt.IdleTimeout,
+		IdleCheckFrequency: opt.IdleCheckFrequency,
+		TLSConfig:          opt.TLSConfig,
+	}
+}
+
+// ClusterOptions returns the options the underlying *goredis.ClusterClient was created with
+func (c ClusterClient) ClusterOptions() *goredis.ClusterOptions {
+	return c.ClusterClient.Options()
+}
+
+// Watch runs `fn` in a transaction watching `keys`, like *goredis.ClusterClient.Watch,
+// with the transaction commands traced like the client's
+func (c ClusterClient) Watch(fn func(*goredis.Tx) error, keys ...string) error {
+	return c.watchContext(c.ClusterClient.Context(), fn, keys...)
+}
+
+// BitField runs BITFIELD over `key` with subcommands `args`, see BaseClient.BitField
+func (c ClusterClient) BitField(key string, args ...interface{}) *goredis.SliceCmd {
+	return bitField(c.ClusterClient.Process, key, args...)
+}
+
+// SubscribeContext subscribes to `channels` until `ctx` is done
+func (c ClusterClient) SubscribeContext(ctx context.Context, channels ...string) (*Subscription, error) {
+	return subscribe(ctx, c.instrumentation.addr, 0, "redis subscribe", func() *goredis.PubSub {
+		return c.ClusterClient.Subscribe(channels...)
+	})
+}
+
+// PSubscribeContext subscribes to channels matching `patterns` until `ctx` is done
+func (c ClusterClient) PSubscribeContext(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return subscribe(ctx, c.instrumentation.addr, 0, "redis psubscribe", func() *goredis.PubSub {
+		return c.ClusterClient.PSubscribe(patterns...)
+	})
+}
+
+var _ Client = (*ClusterClient)(nil)
+var _ LockerClient = (*ClusterClient)(nil)
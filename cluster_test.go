@@ -0,0 +1,49 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+// requireCluster skips the test unless the Redis at `addr` runs in cluster mode,
+// which the redis service of docker-compose.yaml doesn't
+func requireCluster(t *testing.T, addr string) {
+	client := goredis.NewClient(&goredis.Options{Addr: addr, DialTimeout: 20 * time.Millisecond})
+	defer client.Close()
+	if err := client.ClusterSlots().Err(); err != nil {
+		t.Skipf("no Redis Cluster at %s: %v", addr, err)
+	}
+}
+
+func TestClusterClient(t *testing.T) {
+	requireCluster(t, "localhost:6666")
+	client, err := redis.NewClusterClient(&goredis.ClusterOptions{
+		Addrs:       []string{"localhost:6666"},
+		DialTimeout: 20 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+	var c redis.Client = client.WithContext(context.Background())
+	assert.Nil(t, c.Set("key", "value", 0).Err())
+	assert.Equal(t, "value", c.Get("key").Val())
+	assert.Equal(t, "localhost:6666", c.Options().Addr)
+	lock, err := client.Obtain("lock", time.Second, redis.LockOptions{Limit: 1})
+	assert.Nil(t, err)
+	_, err = client.Obtain("lock", time.Second, redis.LockOptions{Limit: 1})
+	assert.True(t, errors.Is(err, redis.ErrLockNotObtained))
+	assert.Nil(t, lock.Release())
+	lock, err = client.Obtain("lock", time.Second, redis.LockOptions{Fair: true})
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, client, mux.On(redis.Hash("some_hash")))
+}
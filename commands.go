@@ -0,0 +1,20 @@
+package redis
+
+import (
+	goredis "github.com/go-redis/redis"
+)
+
+// BitField runs BITFIELD over `key` with subcommands `args`, e.g. "INCRBY", "u8", 0, 1.
+// It's not supported by *goredis.Client, each reply is an int64 or nil for overflows with FAIL
+func (c BaseClient) BitField(key string, args ...interface{}) *goredis.SliceCmd {
+	return bitField(c.Client.Process, key, args...)
+}
+
+func bitField(process func(goredis.Cmder) error, key string, args ...interface{}) *goredis.SliceCmd {
+	cmdArgs := make([]interface{}, 0, 2+len(args))
+	cmdArgs = append(cmdArgs, "bitfield", key)
+	cmdArgs = append(cmdArgs, args...)
+	cmd := goredis.NewSliceCmd(cmdArgs...)
+	process(cmd)
+	return cmd
+}
@@ -0,0 +1,386 @@
+package redis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"gopkg.in/yaml.v2"
+)
+
+// ClientConfig configures a BaseClient. In JSON and YAML it can also be written
+// as just its URL. Durations are strings parsed by time.ParseDuration, e.g. "500ms"
+type ClientConfig struct {
+	// URL of the Redis instance, e.g. redis://:password@localhost:6379/0
+	URL string `json:"url" yaml:"url"`
+	// DialTimeout, also the time to wait for the connection when creating the client
+	// Default: 5s
+	DialTimeout string `json:"dialTimeout" yaml:"dialTimeout"`
+	// ReadTimeout
+	// Default: 3s
+	ReadTimeout string `json:"readTimeout" yaml:"readTimeout"`
+	// WriteTimeout
+	// Default: ReadTimeout
+	WriteTimeout string `json:"writeTimeout" yaml:"writeTimeout"`
+	// PoolSize is the maximum number of connections
+	// Default: 10 per CPU
+	PoolSize int `json:"poolSize" yaml:"poolSize"`
+}
+
+// LockConfig configures the LockOptions of a Mux, see LockOptions
+type LockConfig struct {
+	MinTime string `json:"minTime" yaml:"minTime"`
+	MaxTime string `json:"maxTime" yaml:"maxTime"`
+	Limit   int    `json:"limit" yaml:"limit"`
+	Fair    bool   `json:"fair" yaml:"fair"`
+}
+
+// MuxConfig configures a BaseMux and its clients, see MuxOptions.
+// Clients with the same URL share a BaseClient
+type MuxConfig struct {
+	HashClient    ClientConfig   `json:"hashClient" yaml:"hashClient"`
+	Clients       []ClientConfig `json:"clients" yaml:"clients"`
+	HashMapTTL    string         `json:"hashMapTTL" yaml:"hashMapTTL"`
+	HashKeyPrefix string         `json:"hashKeyPrefix" yaml:"hashKeyPrefix"`
+	WithLockOnTTL string         `json:"withLockOnTTL" yaml:"withLockOnTTL"`
+	Lock          *LockConfig    `json:"lock" yaml:"lock"`
+}
+
+// NewMuxConfig returns a MuxConfig keeping mappings in `hashClient`
+// and multiplexing to `clients`, all of them Redis URLs
+func NewMuxConfig(hashClient string, clients ...string) MuxConfig {
+	config := MuxConfig{HashClient: ClientConfig{URL: hashClient}}
+	for _, url := range clients {
+		config.Clients = append(config.Clients, ClientConfig{URL: url})
+	}
+	return config
+}
+
+// LoadMuxConfigJSON reads a MuxConfig from JSON, failing on unknown fields.
+// It's validated, with the FieldError of an invalid setting naming its path
+func LoadMuxConfigJSON(r io.Reader) (MuxConfig, error) {
+	var config MuxConfig
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return MuxConfig{}, err
+	}
+	if err := config.Validate(); err != nil {
+		return MuxConfig{}, err
+	}
+	return config, nil
+}
+
+// LoadMuxConfigYAML reads a MuxConfig from YAML, failing on unknown fields.
+// It's validated, with the FieldError of an invalid setting naming its path
+func LoadMuxConfigYAML(r io.Reader) (MuxConfig, error) {
+	var config MuxConfig
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return MuxConfig{}, err
+	}
+	if err := config.Validate(); err != nil {
+		return MuxConfig{}, err
+	}
+	return config, nil
+}
+
+// LoadMuxConfigEnv reads a MuxConfig from environment variables named `prefix` followed by:
+// HASH_CLIENT and CLIENTS (comma separated) URLs; DIAL_TIMEOUT, READ_TIMEOUT, WRITE_TIMEOUT
+// and POOL_SIZE, set on all clients; HASH_MAP_TTL, HASH_KEY_PREFIX, WITH_LOCK_ON_TTL;
+// LOCK_MIN_TIME, LOCK_MAX_TIME, LOCK_LIMIT and LOCK_FAIR.
+// It's validated, with the FieldError of an invalid setting naming its variable
+func LoadMuxConfigEnv(prefix string) (MuxConfig, error) {
+	env := func(name string) string {
+		return os.Getenv(prefix + name)
+	}
+	client := ClientConfig{
+		DialTimeout:  env("DIAL_TIMEOUT"),
+		ReadTimeout:  env("READ_TIMEOUT"),
+		WriteTimeout: env("WRITE_TIMEOUT"),
+	}
+	if v := env("POOL_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			return MuxConfig{}, &FieldError{Field: prefix + "POOL_SIZE", Err: err}
+		}
+		client.PoolSize = size
+	}
+	config := MuxConfig{
+		HashMapTTL:    env("HASH_MAP_TTL"),
+		HashKeyPrefix: env("HASH_KEY_PREFIX"),
+		WithLockOnTTL: env("WITH_LOCK_ON_TTL"),
+	}
+	config.HashClient = client
+	config.HashClient.URL = env("HASH_CLIENT")
+	for _, url := range strings.Split(env("CLIENTS"), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			c := client
+			c.URL = url
+			config.Clients = append(config.Clients, c)
+		}
+	}
+	lock := LockConfig{MinTime: env("LOCK_MIN_TIME"), MaxTime: env("LOCK_MAX_TIME")}
+	if v := env("LOCK_LIMIT"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return MuxConfig{}, &FieldError{Field: prefix + "LOCK_LIMIT", Err: err}
+		}
+		lock.Limit = limit
+	}
+	if v := env("LOCK_FAIR"); v != "" {
+		fair, err := strconv.ParseBool(v)
+		if err != nil {
+			return MuxConfig{}, &FieldError{Field: prefix + "LOCK_FAIR", Err: err}
+		}
+		lock.Fair = fair
+	}
+	if lock != (LockConfig{}) {
+		config.Lock = &lock
+	}
+	if err := config.Validate(); err != nil {
+		var fieldErr *FieldError
+		if errors.As(err, &fieldErr) {
+			return MuxConfig{}, &FieldError{Field: envField(prefix, fieldErr.Field), Err: fieldErr.Err}
+		}
+		return MuxConfig{}, err
+	}
+	return config, nil
+}
+
+// envFields are the environment variables of LoadMuxConfigEnv setting each field of a MuxConfig,
+// named by their path without client indexes. Client settings are set on every client
+var envFields = map[string]string{
+	"hashClient.url": "HASH_CLIENT",
+	"clients":        "CLIENTS",
+	"clients.url":    "CLIENTS",
+	"dialTimeout":    "DIAL_TIMEOUT",
+	"readTimeout":    "READ_TIMEOUT",
+	"writeTimeout":   "WRITE_TIMEOUT",
+	"poolSize":       "POOL_SIZE",
+	"hashMapTTL":     "HASH_MAP_TTL",
+	"hashKeyPrefix":  "HASH_KEY_PREFIX",
+	"withLockOnTTL":  "WITH_LOCK_ON_TTL",
+	"lock.minTime":   "LOCK_MIN_TIME",
+	"lock.maxTime":   "LOCK_MAX_TIME",
+	"lock.limit":     "LOCK_LIMIT",
+	"lock.fair":      "LOCK_FAIR",
+}
+
+var clientIndex = regexp.MustCompile(`\[\d+\]`)
+
+// envField returns the environment variable named after `prefix` setting the MuxConfig `field`,
+// e.g. clients[0].dialTimeout is set by DIAL_TIMEOUT
+func envField(prefix, field string) string {
+	path := clientIndex.ReplaceAllString(field, "")
+	if name, ok := envFields[path]; ok {
+		return prefix + name
+	}
+	for _, client := range []string{"hashClient.", "clients."} {
+		if name, ok := envFields[strings.TrimPrefix(path, client)]; ok && strings.HasPrefix(path, client) {
+			return prefix + name
+		}
+	}
+	return field
+}
+
+// UnmarshalJSON reads a ClientConfig from an object or a URL string
+func (c *ClientConfig) UnmarshalJSON(data []byte) error {
+	var url string
+	if err := json.Unmarshal(data, &url); err == nil {
+		*c = ClientConfig{URL: url}
+		return nil
+	}
+	type plain ClientConfig
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode((*plain)(c))
+}
+
+// UnmarshalYAML reads a ClientConfig from a mapping or a URL string
+func (c *ClientConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var url string
+	if err := unmarshal(&url); err == nil {
+		*c = ClientConfig{URL: url}
+		return nil
+	}
+	type plain ClientConfig
+	return unmarshal((*plain)(c))
+}
+
+// Validate ClientConfig
+func (c ClientConfig) Validate() error {
+	_, err := c.options("")
+	return err
+}
+
+// Options returns the *goredis.Options configured by c
+func (c ClientConfig) Options() (*goredis.Options, error) {
+	return c.options("")
+}
+
+// options returns the *goredis.Options configured by c,
+// naming fields in errors after `path`, the path of c in its configuration
+func (c ClientConfig) options(path string) (*goredis.Options, error) {
+	if c.URL == "" {
+		return nil, &FieldError{Field: path + "url", Err: errors.New("is required")}
+	}
+	opt, err := goredis.ParseURL(c.URL)
+	if err != nil {
+		return nil, &FieldError{Field: path + "url", Err: err}
+	}
+	durations := []struct {
+		field string
+		value string
+		dest  *time.Duration
+	}{
+		{"dialTimeout", c.DialTimeout, &opt.DialTimeout},
+		{"readTimeout", c.ReadTimeout, &opt.ReadTimeout},
+		{"writeTimeout", c.WriteTimeout, &opt.WriteTimeout},
+	}
+	for _, d := range durations {
+		if err := parseDuration(path+d.field, d.value, d.dest); err != nil {
+			return nil, err
+		}
+	}
+	if c.PoolSize < 0 {
+		return nil, &FieldError{Field: path + "poolSize", Err: errors.New("can't be negative")}
+	}
+	opt.PoolSize = c.PoolSize
+	return opt, nil
+}
+
+// NewClient creates a BaseClient configured by c, see NewClient
+func (c ClientConfig) NewClient(connect ...ConnectOption) (*BaseClient, error) {
+	opt, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(opt, connect...)
+}
+
+// Validate MuxConfig
+func (c MuxConfig) Validate() error {
+	_, _, err := c.options()
+	return err
+}
+
+// options validates c returning the options of its clients
+// and its MuxOptions, without HashClient and Clients
+func (c MuxConfig) options() (map[string]*goredis.Options, MuxOptions, error) {
+	clients := make(map[string]*goredis.Options, len(c.Clients)+1)
+	configs := make(map[string]ClientConfig, len(c.Clients)+1)
+	opt, err := c.HashClient.options("hashClient.")
+	if err != nil {
+		return nil, MuxOptions{}, err
+	}
+	clients[c.HashClient.URL] = opt
+	configs[c.HashClient.URL] = c.HashClient
+	if len(c.Clients) == 0 {
+		return nil, MuxOptions{}, &FieldError{Field: "clients", Err: errors.New("at least one is required")}
+	}
+	for i, client := range c.Clients {
+		path := fmt.Sprintf("clients[%d].", i)
+		opt, err := client.options(path)
+		if err != nil {
+			return nil, MuxOptions{}, err
+		}
+		if other, ok := configs[client.URL]; ok && other != client {
+			return nil, MuxOptions{}, &FieldError{
+				Field: path + "url",
+				Err:   errors.New("is configured more than once with different settings"),
+			}
+		}
+		clients[client.URL] = opt
+		configs[client.URL] = client
+	}
+	muxopt := MuxOptions{HashKeyPrefix: c.HashKeyPrefix}
+	if err := parseDuration("hashMapTTL", c.HashMapTTL, &muxopt.HashMapTTL); err != nil {
+		return nil, MuxOptions{}, err
+	}
+	if err := parseDuration("withLockOnTTL", c.WithLockOnTTL, &muxopt.WithLockOnTTL); err != nil {
+		return nil, MuxOptions{}, err
+	}
+	if c.Lock != nil {
+		lockopt := DefaultLockOptions()
+		if err := parseDuration("lock.minTime", c.Lock.MinTime, &lockopt.MinTime); err != nil {
+			return nil, MuxOptions{}, err
+		}
+		if err := parseDuration("lock.maxTime", c.Lock.MaxTime, &lockopt.MaxTime); err != nil {
+			return nil, MuxOptions{}, err
+		}
+		if lockopt.MaxTime < lockopt.MinTime {
+			return nil, MuxOptions{}, &FieldError{Field: "lock.maxTime", Err: errors.New("is less than lock.minTime")}
+		}
+		if c.Lock.Limit < 0 {
+			return nil, MuxOptions{}, &FieldError{Field: "lock.limit", Err: errors.New("can't be negative")}
+		}
+		if c.Lock.Limit > 0 {
+			lockopt.Limit = c.Lock.Limit
+		}
+		lockopt.Fair = c.Lock.Fair
+		muxopt.LockOptions = &lockopt
+	}
+	return clients, muxopt, nil
+}
+
+// NewMux creates a BaseMux and all its clients as configured by c.
+// Clients are created with `connect`, see NewClient
+func (c MuxConfig) NewMux(connect ...ConnectOption) (*BaseMux, error) {
+	opts, muxopt, err := c.options()
+	if err != nil {
+		return nil, err
+	}
+	clients := make(map[string]*BaseClient, len(opts))
+	closeAll := func() {
+		for _, client := range clients {
+			client.Close()
+		}
+	}
+	for url, opt := range opts {
+		client, err := NewClient(opt, connect...)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		clients[url] = client
+	}
+	muxopt.HashClient = clients[c.HashClient.URL]
+	for _, client := range c.Clients {
+		muxopt.Clients = append(muxopt.Clients, clients[client.URL])
+	}
+	mux, err := NewMux(muxopt)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	return mux, nil
+}
+
+// parseDuration parses `value` into `dest` if it isn't empty
+func parseDuration(field, value string, dest *time.Duration) error {
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return &FieldError{Field: field, Err: err}
+	}
+	if d < 0 {
+		return &FieldError{Field: field, Err: errors.New("can't be negative")}
+	}
+	*dest = d
+	return nil
+}
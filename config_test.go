@@ -0,0 +1,186 @@
+package redis_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestLoadMuxConfigYAML(t *testing.T) {
+	config, err := redis.LoadMuxConfigYAML(strings.NewReader(`
+hashClient:
+  url: redis://localhost:6666
+  dialTimeout: 20ms
+clients:
+  - redis://0.0.0.0:6666
+  - url: redis://localhost:6666
+    dialTimeout: 20ms
+hashMapTTL: 1m
+lock:
+  minTime: 10ms
+  maxTime: 20ms
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "redis://0.0.0.0:6666", config.Clients[0].URL)
+	assert.Equal(t, "20ms", config.Clients[1].DialTimeout)
+	mux, err := config.NewMux()
+	assert.Nil(t, err)
+	assert.Len(t, mux.All(), 2)
+	assert.Nil(t, mux.On(redis.Hash("some_hash")).Set("key", "value", 0).Err())
+}
+
+func TestLoadMuxConfigJSON(t *testing.T) {
+	config, err := redis.LoadMuxConfigJSON(strings.NewReader(`{
+		"hashClient": "redis://localhost:6666",
+		"clients": ["redis://localhost:6666", {"url": "redis://0.0.0.0:6666", "poolSize": 2}],
+		"withLockOnTTL": "1s"
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, redis.NewMuxConfig("redis://localhost:6666", "redis://localhost:6666"), redis.MuxConfig{
+		HashClient: config.HashClient,
+		Clients:    config.Clients[:1],
+	})
+	assert.Equal(t, 2, config.Clients[1].PoolSize)
+	assert.Nil(t, config.Validate())
+}
+
+func TestLoadMuxConfigJSON_UnknownFields(t *testing.T) {
+	for _, data := range []string{
+		`{"hashClient": "redis://localhost:6666", "clients": ["redis://localhost:6666"], "lockTTL": "1s"}`,
+		`{"hashClient": {"url": "redis://localhost:6666", "connectTimeout": "1s"}, "clients": ["redis://localhost:6666"]}`,
+	} {
+		_, err := redis.LoadMuxConfigJSON(strings.NewReader(data))
+		assert.NotNil(t, err, data)
+	}
+}
+
+func TestLoadMuxConfig_FieldErrors(t *testing.T) {
+	for _, tt := range []struct {
+		load  func(io.Reader) (redis.MuxConfig, error)
+		data  string
+		field string
+	}{
+		{redis.LoadMuxConfigJSON, `{"hashClient": "redis://localhost:6666"}`, "clients"},
+		{redis.LoadMuxConfigJSON, `{"hashClient": "redis://localhost:6666", "clients": [{"url": "redis://localhost:6666", "poolSize": -1}]}`, "clients[0].poolSize"},
+		{redis.LoadMuxConfigYAML, "hashClient: redis://localhost:6666\nclients: [redis://localhost:6666]\nhashMapTTL: soon\n", "hashMapTTL"},
+		{redis.LoadMuxConfigYAML, "hashClient: redis://localhost:6666\nclients: [redis://localhost:6666]\nlock: {minTime: 20ms, maxTime: 10ms}\n", "lock.maxTime"},
+	} {
+		_, err := tt.load(strings.NewReader(tt.data))
+		var fieldErr *redis.FieldError
+		if assert.True(t, errors.As(err, &fieldErr), tt.data) {
+			assert.Equal(t, tt.field, fieldErr.Field, tt.data)
+		}
+		assert.True(t, errors.Is(err, redis.ErrInvalidOptions), tt.data)
+	}
+}
+
+func TestLoadMuxConfigEnv(t *testing.T) {
+	env := map[string]string{
+		"TEST_REDIS_HASH_CLIENT":  "redis://localhost:6666",
+		"TEST_REDIS_CLIENTS":      "redis://localhost:6666, redis://0.0.0.0:6666",
+		"TEST_REDIS_DIAL_TIMEOUT": "20ms",
+		"TEST_REDIS_LOCK_FAIR":    "true",
+	}
+	for k, v := range env {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+	config, err := redis.LoadMuxConfigEnv("TEST_REDIS_")
+	assert.Nil(t, err)
+	assert.Len(t, config.Clients, 2)
+	assert.Equal(t, "20ms", config.Clients[1].DialTimeout)
+	assert.True(t, config.Lock.Fair)
+	_, err = config.NewMux()
+	assert.Nil(t, err)
+	os.Setenv("TEST_REDIS_LOCK_LIMIT", "many")
+	defer os.Unsetenv("TEST_REDIS_LOCK_LIMIT")
+	_, err = redis.LoadMuxConfigEnv("TEST_REDIS_")
+	var fieldErr *redis.FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "TEST_REDIS_LOCK_LIMIT", fieldErr.Field)
+}
+
+func TestLoadMuxConfigEnv_FieldErrors(t *testing.T) {
+	tests := []struct {
+		field string
+		env   map[string]string
+	}{
+		{"TEST_REDIS_HASH_CLIENT", map[string]string{"TEST_REDIS_CLIENTS": "redis://localhost:6666"}},
+		{"TEST_REDIS_CLIENTS", map[string]string{"TEST_REDIS_HASH_CLIENT": "redis://localhost:6666"}},
+		{"TEST_REDIS_CLIENTS", map[string]string{
+			"TEST_REDIS_HASH_CLIENT": "redis://localhost:6666",
+			"TEST_REDIS_CLIENTS":     "redis://localhost:6666,http://localhost",
+		}},
+		{"TEST_REDIS_DIAL_TIMEOUT", map[string]string{
+			"TEST_REDIS_HASH_CLIENT":  "redis://localhost:6666",
+			"TEST_REDIS_CLIENTS":      "redis://localhost:6666",
+			"TEST_REDIS_DIAL_TIMEOUT": "soon",
+		}},
+		{"TEST_REDIS_POOL_SIZE", map[string]string{
+			"TEST_REDIS_HASH_CLIENT": "redis://localhost:6666",
+			"TEST_REDIS_CLIENTS":     "redis://localhost:6666",
+			"TEST_REDIS_POOL_SIZE":   "-1",
+		}},
+		{"TEST_REDIS_LOCK_MAX_TIME", map[string]string{
+			"TEST_REDIS_HASH_CLIENT":   "redis://localhost:6666",
+			"TEST_REDIS_CLIENTS":       "redis://localhost:6666",
+			"TEST_REDIS_LOCK_MIN_TIME": "1s",
+		}},
+	}
+	for _, tt := range tests {
+		for k, v := range tt.env {
+			os.Setenv(k, v)
+		}
+		_, err := redis.LoadMuxConfigEnv("TEST_REDIS_")
+		for k := range tt.env {
+			os.Unsetenv(k)
+		}
+		var fieldErr *redis.FieldError
+		if assert.True(t, errors.As(err, &fieldErr), tt.field) {
+			assert.Equal(t, tt.field, fieldErr.Field)
+		}
+	}
+}
+
+func TestMuxConfig_Validate(t *testing.T) {
+	tests := []struct {
+		field  string
+		config redis.MuxConfig
+	}{
+		{"hashClient.url", redis.NewMuxConfig("", "redis://localhost:6666")},
+		{"clients", redis.NewMuxConfig("redis://localhost:6666")},
+		{"clients[1].url", redis.NewMuxConfig("redis://localhost:6666", "redis://localhost:6666", "http://localhost")},
+		{"clients[0].dialTimeout", redis.MuxConfig{
+			HashClient: redis.ClientConfig{URL: "redis://localhost:6666"},
+			Clients:    []redis.ClientConfig{{URL: "redis://0.0.0.0:6666", DialTimeout: "soon"}},
+		}},
+		{"clients[0].url", redis.MuxConfig{
+			HashClient: redis.ClientConfig{URL: "redis://localhost:6666"},
+			Clients:    []redis.ClientConfig{{URL: "redis://localhost:6666", PoolSize: 1}},
+		}},
+		{"hashMapTTL", redis.MuxConfig{
+			HashClient: redis.ClientConfig{URL: "redis://localhost:6666"},
+			Clients:    []redis.ClientConfig{{URL: "redis://localhost:6666"}},
+			HashMapTTL: "-1s",
+		}},
+		{"lock.maxTime", redis.MuxConfig{
+			HashClient: redis.ClientConfig{URL: "redis://localhost:6666"},
+			Clients:    []redis.ClientConfig{{URL: "redis://localhost:6666"}},
+			Lock:       &redis.LockConfig{MinTime: time.Second.String()},
+		}},
+	}
+	for _, tt := range tests {
+		err := tt.config.Validate()
+		assert.True(t, errors.Is(err, redis.ErrInvalidOptions), tt.field)
+		var fieldErr *redis.FieldError
+		if assert.True(t, errors.As(err, &fieldErr), tt.field) {
+			assert.Equal(t, tt.field, fieldErr.Field)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// ConnectOption changes how a client constructor (NewClient, NewFailoverClient,
+// NewClusterClient) waits for its connection to Redis
+type ConnectOption func(*connectOptions)
+
+type connectOptions struct {
+	lazy       bool
+	ctx        context.Context
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	onReady    func(error)
+}
+
+// WithLazyConnect makes the constructor return without waiting for the connection,
+// which is awaited in the background instead, until it's established or the context
+// set by WithConnectContext is done. Commands fail until then, so use WithReadyCallback
+// to know when the client is ready
+func WithLazyConnect() ConnectOption {
+	return func(opt *connectOptions) {
+		opt.lazy = true
+	}
+}
+
+// WithConnectContext makes the wait for the connection last until `ctx` is done,
+// instead of the client DialTimeout or, with WithLazyConnect, forever
+func WithConnectContext(ctx context.Context) ConnectOption {
+	return func(opt *connectOptions) {
+		opt.ctx = ctx
+	}
+}
+
+// WithConnectBackoff sets how long to wait between failed pings while waiting for the connection,
+// doubling from `min` up to `max`
+// Default: from 10ms to 1s
+func WithConnectBackoff(min, max time.Duration) ConnectOption {
+	return func(opt *connectOptions) {
+		opt.minBackoff = min
+		opt.maxBackoff = max
+	}
+}
+
+// WithReadyCallback sets `f` to be called once the wait for the connection is over,
+// with nil if it's established or with the reason it isn't, an ErrConnectTimeout
+func WithReadyCallback(f func(error)) ConnectOption {
+	return func(opt *connectOptions) {
+		opt.onReady = f
+	}
+}
+
+// waitConnection waits for `client` to be connected as set by `connect`, by default
+// failing with ErrConnectTimeout if it isn't after `dialTimeout`
+func waitConnection(client pinger, dialTimeout time.Duration, connect []ConnectOption) error {
+	opt := connectOptions{
+		minBackoff: 10 * time.Millisecond,
+		maxBackoff: time.Second,
+		onReady:    func(error) {},
+	}
+	for _, o := range connect {
+		o(&opt)
+	}
+	ctx := opt.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opt.lazy {
+		go func() {
+			opt.onReady(pingUntilConnected(ctx, client, opt.minBackoff, opt.maxBackoff))
+		}()
+		return nil
+	}
+	if opt.ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dialTimeout)
+		defer cancel()
+	}
+	err := pingUntilConnected(ctx, client, opt.minBackoff, opt.maxBackoff)
+	opt.onReady(err)
+	return err
+}
+
+// pingUntilConnected pings `client` with an exponential backoff until it's connected,
+// it's closed or `ctx` is done
+func pingUntilConnected(ctx context.Context, client pinger, minBackoff, maxBackoff time.Duration) error {
+	backoff := minBackoff
+	var timer *time.Timer
+	for {
+		err := ping(client)
+		if err == nil {
+			return nil
+		}
+		// go-redis never reconnects closed clients
+		if err == errClientClosed {
+			return wrapError(ErrConnectTimeout, err)
+		}
+		if timer == nil {
+			timer = time.NewTimer(backoff)
+			defer timer.Stop()
+		} else {
+			timer.Reset(backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return wrapError(ErrConnectTimeout, err)
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// errClientClosed is the error commands of closed go-redis clients fail with, which it doesn't export
+var errClientClosed = func() error {
+	client := goredis.NewClient(&goredis.Options{
+		// no idle connections reaper
+		IdleTimeout: -1,
+	})
+	client.Close()
+	return client.Ping().Err()
+}()
+
+// pinger is a go-redis client waitConnection can ping
+type pinger interface {
+	Ping() *goredis.StatusCmd
+}
+
+// ping returns nil if client is connected, otherwise the reason it isn't
+func ping(client pinger) error {
+	result := client.Ping()
+	if result == nil {
+		return ErrConnectTimeout
+	}
+	str, err := result.Result()
+	if err != nil {
+		return err
+	}
+	if str != "PONG" {
+		return fmt.Errorf("unexpected reply to PING: %s", str)
+	}
+	return nil
+}
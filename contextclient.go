@@ -0,0 +1,958 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// ContextClient is a Client whose commands take their context as first argument,
+// in the style of go-redis v8+, instead of running under a WithContext copy
+type ContextClient interface {
+	Append(ctx context.Context, key, value string) *goredis.IntCmd
+	BLPop(ctx context.Context, timeout time.Duration, keys ...string) *goredis.StringSliceCmd
+	BRPop(ctx context.Context, timeout time.Duration, keys ...string) *goredis.StringSliceCmd
+	BRPopLPush(ctx context.Context, source, destination string, timeout time.Duration) *goredis.StringCmd
+	BZPopMax(ctx context.Context, timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd
+	BZPopMin(ctx context.Context, timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd
+	BitCount(ctx context.Context, key string, bitCount *goredis.BitCount) *goredis.IntCmd
+	BitField(ctx context.Context, key string, args ...interface{}) *goredis.SliceCmd
+	BitOpAnd(ctx context.Context, destKey string, keys ...string) *goredis.IntCmd
+	BitOpOr(ctx context.Context, destKey string, keys ...string) *goredis.IntCmd
+	BitPos(ctx context.Context, key string, bit int64, pos ...int64) *goredis.IntCmd
+	Client() Client
+	Decr(ctx context.Context, key string) *goredis.IntCmd
+	DecrBy(ctx context.Context, key string, decrement int64) *goredis.IntCmd
+	Del(ctx context.Context, keys ...string) *goredis.IntCmd
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *goredis.Cmd
+	EvalSha(ctx context.Context, sha1 string, keys []string, args ...interface{}) *goredis.Cmd
+	Exists(ctx context.Context, keys ...string) *goredis.IntCmd
+	Expire(ctx context.Context, key string, expiration time.Duration) *goredis.BoolCmd
+	ExpireAt(ctx context.Context, key string, tm time.Time) *goredis.BoolCmd
+	GeoAdd(ctx context.Context, key string, geoLocation ...*goredis.GeoLocation) *goredis.IntCmd
+	GeoDist(ctx context.Context, key string, member1, member2, unit string) *goredis.FloatCmd
+	GeoHash(ctx context.Context, key string, members ...string) *goredis.StringSliceCmd
+	GeoPos(ctx context.Context, key string, members ...string) *goredis.GeoPosCmd
+	GeoRadius(ctx context.Context, key string, longitude, latitude float64, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd
+	GeoRadiusByMember(ctx context.Context, key, member string, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd
+	Get(ctx context.Context, key string) *goredis.StringCmd
+	GetBit(ctx context.Context, key string, offset int64) *goredis.IntCmd
+	GetRange(ctx context.Context, key string, start, end int64) *goredis.StringCmd
+	GetSet(ctx context.Context, key string, value interface{}) *goredis.StringCmd
+	HDel(ctx context.Context, key string, fields ...string) *goredis.IntCmd
+	HGet(ctx context.Context, key, field string) *goredis.StringCmd
+	HGetAll(ctx context.Context, key string) *goredis.StringStringMapCmd
+	HMGet(ctx context.Context, key string, fields ...string) *goredis.SliceCmd
+	HMSet(ctx context.Context, key string, fields map[string]interface{}) *goredis.StatusCmd
+	HScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd
+	HSet(ctx context.Context, key, field string, value interface{}) *goredis.BoolCmd
+	Incr(ctx context.Context, key string) *goredis.IntCmd
+	IncrBy(ctx context.Context, key string, value int64) *goredis.IntCmd
+	LIndex(ctx context.Context, key string, index int64) *goredis.StringCmd
+	LLen(ctx context.Context, key string) *goredis.IntCmd
+	LPop(ctx context.Context, key string) *goredis.StringCmd
+	LPush(ctx context.Context, key string, values ...interface{}) *goredis.IntCmd
+	LRange(ctx context.Context, key string, start, stop int64) *goredis.StringSliceCmd
+	LRem(ctx context.Context, key string, count int64, value interface{}) *goredis.IntCmd
+	LTrim(ctx context.Context, key string, start, stop int64) *goredis.StatusCmd
+	MGet(ctx context.Context, keys ...string) *goredis.SliceCmd
+	MSet(ctx context.Context, pairs ...interface{}) *goredis.StatusCmd
+	PExpire(ctx context.Context, key string, expiration time.Duration) *goredis.BoolCmd
+	PExpireAt(ctx context.Context, key string, tm time.Time) *goredis.BoolCmd
+	PFAdd(ctx context.Context, key string, els ...interface{}) *goredis.IntCmd
+	PFCount(ctx context.Context, keys ...string) *goredis.IntCmd
+	PFMerge(ctx context.Context, dest string, keys ...string) *goredis.StatusCmd
+	PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error)
+	PTTL(ctx context.Context, key string) *goredis.DurationCmd
+	Persist(ctx context.Context, key string) *goredis.BoolCmd
+	Ping(ctx context.Context) *goredis.StatusCmd
+	Pipelined(ctx context.Context, fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error)
+	Publish(ctx context.Context, channel string, message interface{}) *goredis.IntCmd
+	RPop(ctx context.Context, key string) *goredis.StringCmd
+	RPopLPush(ctx context.Context, source string, destination string) *goredis.StringCmd
+	RPush(ctx context.Context, key string, values ...interface{}) *goredis.IntCmd
+	Rename(ctx context.Context, key, newkey string) *goredis.StatusCmd
+	SAdd(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd
+	SCard(ctx context.Context, key string) *goredis.IntCmd
+	SIsMember(ctx context.Context, key string, member interface{}) *goredis.BoolCmd
+	SMembers(ctx context.Context, key string) *goredis.StringSliceCmd
+	SPopN(ctx context.Context, key string, count int64) *goredis.StringSliceCmd
+	SRem(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd
+	SScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *goredis.ScanCmd
+	ScriptExists(ctx context.Context, scripts ...string) *goredis.BoolSliceCmd
+	ScriptLoad(ctx context.Context, script string) *goredis.StringCmd
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *goredis.StatusCmd
+	SetBit(ctx context.Context, key string, offset int64, value int) *goredis.IntCmd
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *goredis.BoolCmd
+	SetRange(ctx context.Context, key string, offset int64, value string) *goredis.IntCmd
+	StrLen(ctx context.Context, key string) *goredis.IntCmd
+	Subscribe(ctx context.Context, channels ...string) (*Subscription, error)
+	TTL(ctx context.Context, key string) *goredis.DurationCmd
+	TxPipelined(ctx context.Context, fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error)
+	Type(ctx context.Context, key string) *goredis.StatusCmd
+	Unlink(ctx context.Context, keys ...string) *goredis.IntCmd
+	Watch(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error
+	XAck(ctx context.Context, stream, group string, ids ...string) *goredis.IntCmd
+	XAdd(ctx context.Context, a *goredis.XAddArgs) *goredis.StringCmd
+	XClaim(ctx context.Context, a *goredis.XClaimArgs) *goredis.XMessageSliceCmd
+	XClaimJustID(ctx context.Context, a *goredis.XClaimArgs) *goredis.StringSliceCmd
+	XDel(ctx context.Context, stream string, ids ...string) *goredis.IntCmd
+	XGroupCreate(ctx context.Context, stream, group, start string) *goredis.StatusCmd
+	XGroupCreateMkStream(ctx context.Context, stream, group, start string) *goredis.StatusCmd
+	XGroupDelConsumer(ctx context.Context, stream, group, consumer string) *goredis.IntCmd
+	XGroupDestroy(ctx context.Context, stream, group string) *goredis.IntCmd
+	XGroupSetID(ctx context.Context, stream, group, start string) *goredis.StatusCmd
+	XLen(ctx context.Context, stream string) *goredis.IntCmd
+	XPending(ctx context.Context, stream, group string) *goredis.XPendingCmd
+	XPendingExt(ctx context.Context, a *goredis.XPendingExtArgs) *goredis.XPendingExtCmd
+	XRange(ctx context.Context, stream, start, stop string) *goredis.XMessageSliceCmd
+	XRead(ctx context.Context, a *goredis.XReadArgs) *goredis.XStreamSliceCmd
+	XReadGroup(ctx context.Context, a *goredis.XReadGroupArgs) *goredis.XStreamSliceCmd
+	XTrim(ctx context.Context, key string, maxLen int64) *goredis.IntCmd
+	XTrimApprox(ctx context.Context, key string, maxLen int64) *goredis.IntCmd
+	ZAdd(ctx context.Context, key string, members ...goredis.Z) *goredis.IntCmd
+	ZCard(ctx context.Context, key string) *goredis.IntCmd
+	ZCount(ctx context.Context, key, min, max string) *goredis.IntCmd
+	ZIncrBy(ctx context.Context, key string, increment float64, member string) *goredis.FloatCmd
+	ZInterStore(ctx context.Context, destination string, store goredis.ZStore, keys ...string) *goredis.IntCmd
+	ZPopMax(ctx context.Context, key string, count ...int64) *goredis.ZSliceCmd
+	ZPopMin(ctx context.Context, key string, count ...int64) *goredis.ZSliceCmd
+	ZRange(ctx context.Context, key string, start, stop int64) *goredis.StringSliceCmd
+	ZRangeByScore(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd
+	ZRangeByScoreWithScores(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd
+	ZRangeWithScores(ctx context.Context, key string, start, stop int64) *goredis.ZSliceCmd
+	ZRank(ctx context.Context, key, member string) *goredis.IntCmd
+	ZRem(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd
+	ZRemRangeByRank(ctx context.Context, key string, start, stop int64) *goredis.IntCmd
+	ZRemRangeByScore(ctx context.Context, key, min, max string) *goredis.IntCmd
+	ZRevRangeByScore(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd
+	ZRevRangeByScoreWithScores(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd
+	ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) *goredis.ZSliceCmd
+	ZRevRank(ctx context.Context, key, member string) *goredis.IntCmd
+	ZScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd
+	ZScore(ctx context.Context, key, member string) *goredis.FloatCmd
+	ZUnionStore(ctx context.Context, dest string, store goredis.ZStore, keys ...string) *goredis.IntCmd
+}
+
+// ContextLocker is a Locker whose Obtain takes its context as first argument
+type ContextLocker interface {
+	Obtain(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error)
+}
+
+// ContextLockerClient guarantees that implementers are both ContextClient and ContextLocker
+type ContextLockerClient interface {
+	ContextClient
+	ContextLocker
+}
+
+// contextClient adapts a Client to ContextClient. Commands are built by cmdBuilder
+// and run by the client's contextProcessor, traced under the context of each call
+type contextClient struct {
+	client    Client
+	processor contextProcessor
+}
+
+// NewContextClient returns a ContextClient running its commands through `client`
+func NewContextClient(client Client) ContextClient {
+	return newContextClient(client)
+}
+
+func newContextClient(client Client) contextClient {
+	processor, ok := client.(contextProcessor)
+	if !ok {
+		processor = clientProcessor{client: client}
+	}
+	return contextClient{client: client, processor: processor}
+}
+
+// contextLockerClient adapts a LockerClient to ContextLockerClient
+type contextLockerClient struct {
+	contextClient
+	locker Locker
+}
+
+// NewContextLockerClient returns a ContextLockerClient running its commands
+// and obtaining its locks through `client`
+func NewContextLockerClient(client LockerClient) ContextLockerClient {
+	return contextLockerClient{contextClient: newContextClient(client), locker: client}
+}
+
+// Obtain tries to hold a lock over `key` during `ttl` duration, retrying until `ctx` is done.
+// It fails with ErrInvalidOptions if the client isn't a ContextObtainer. See ContextObtainer.ObtainContext
+func (c contextLockerClient) Obtain(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	return obtainContext(c.locker, ctx, key, ttl, opt)
+}
+
+// obtainContext is ObtainContext on `locker`, failing with ErrInvalidOptions if it isn't a ContextObtainer
+func obtainContext(locker Locker, ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	obtainer, ok := locker.(ContextObtainer)
+	if !ok {
+		return nil, wrapError(ErrInvalidOptions, fmt.Errorf("%T can't obtain locks under a context", locker))
+	}
+	return obtainer.ObtainContext(ctx, key, ttl, opt)
+}
+
+// Client returns the Client adapted by c
+func (c contextClient) Client() Client {
+	return c.client
+}
+
+func (c contextClient) Pipelined(ctx context.Context, fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error) {
+	return c.pipelined(ctx, cmdBuilder.Pipelined, fn, false)
+}
+
+func (c contextClient) TxPipelined(ctx context.Context, fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error) {
+	return c.pipelined(ctx, cmdBuilder.TxPipelined, fn, true)
+}
+
+// pipelined queues the commands of `fn` on a pipeline of cmdBuilder, by `pipelined`,
+// then runs them under `ctx` as a pipeline, or as a transaction if `tx`.
+// `fn` shouldn't call Exec, since the commands run once it returns
+func (c contextClient) pipelined(
+	ctx context.Context,
+	pipelined func(func(goredis.Pipeliner) error) ([]goredis.Cmder, error),
+	fn func(goredis.Pipeliner) error,
+	tx bool,
+) ([]goredis.Cmder, error) {
+	cmds, err := pipelined(fn)
+	if err != nil || len(cmds) == 0 {
+		return cmds, err
+	}
+	return cmds, c.processor.processPipelineContext(ctx, cmds, tx)
+}
+
+func (c contextClient) Watch(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error {
+	return c.processor.watchContext(ctx, fn, keys...)
+}
+
+// process runs `cmd` under `ctx`
+func (c contextClient) process(ctx context.Context, cmd goredis.Cmder) error {
+	return c.processor.processContext(ctx, cmd)
+}
+
+// scan runs a scan command of `args` and the `match` and `count` options under `ctx`,
+// as does the Iterator over it
+func (c contextClient) scan(ctx context.Context, match string, count int64, args ...interface{}) *goredis.ScanCmd {
+	if match != "" {
+		args = append(args, "match", match)
+	}
+	if count > 0 {
+		args = append(args, "count", count)
+	}
+	cmd := goredis.NewScanCmd(func(cmd goredis.Cmder) error {
+		return c.process(ctx, cmd)
+	}, args...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Subscribe(ctx context.Context, channels ...string) (*Subscription, error) {
+	return c.client.SubscribeContext(ctx, channels...)
+}
+
+func (c contextClient) PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return c.client.PSubscribeContext(ctx, patterns...)
+}
+
+func (c contextClient) Append(ctx context.Context, key, value string) *goredis.IntCmd {
+	cmd := cmdBuilder.Append(key, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BLPop(ctx context.Context, timeout time.Duration, keys ...string) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.BLPop(timeout, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BRPop(ctx context.Context, timeout time.Duration, keys ...string) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.BRPop(timeout, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BRPopLPush(ctx context.Context, source, destination string, timeout time.Duration) *goredis.StringCmd {
+	cmd := cmdBuilder.BRPopLPush(source, destination, timeout)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BZPopMax(ctx context.Context, timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd {
+	cmd := cmdBuilder.BZPopMax(timeout, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BZPopMin(ctx context.Context, timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd {
+	cmd := cmdBuilder.BZPopMin(timeout, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BitCount(ctx context.Context, key string, bitCount *goredis.BitCount) *goredis.IntCmd {
+	cmd := cmdBuilder.BitCount(key, bitCount)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BitField(ctx context.Context, key string, args ...interface{}) *goredis.SliceCmd {
+	return bitField(func(cmd goredis.Cmder) error {
+		return c.process(ctx, cmd)
+	}, key, args...)
+}
+
+func (c contextClient) BitOpAnd(ctx context.Context, destKey string, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.BitOpAnd(destKey, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BitOpOr(ctx context.Context, destKey string, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.BitOpOr(destKey, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) BitPos(ctx context.Context, key string, bit int64, pos ...int64) *goredis.IntCmd {
+	cmd := cmdBuilder.BitPos(key, bit, pos...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Decr(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.Decr(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) DecrBy(ctx context.Context, key string, decrement int64) *goredis.IntCmd {
+	cmd := cmdBuilder.DecrBy(key, decrement)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Del(ctx context.Context, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.Del(keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) *goredis.Cmd {
+	cmd := cmdBuilder.Eval(script, keys, args...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) EvalSha(ctx context.Context, sha1 string, keys []string, args ...interface{}) *goredis.Cmd {
+	cmd := cmdBuilder.EvalSha(sha1, keys, args...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Exists(ctx context.Context, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.Exists(keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Expire(ctx context.Context, key string, expiration time.Duration) *goredis.BoolCmd {
+	cmd := cmdBuilder.Expire(key, expiration)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ExpireAt(ctx context.Context, key string, tm time.Time) *goredis.BoolCmd {
+	cmd := cmdBuilder.ExpireAt(key, tm)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoAdd(ctx context.Context, key string, geoLocation ...*goredis.GeoLocation) *goredis.IntCmd {
+	cmd := cmdBuilder.GeoAdd(key, geoLocation...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoDist(ctx context.Context, key string, member1, member2, unit string) *goredis.FloatCmd {
+	cmd := cmdBuilder.GeoDist(key, member1, member2, unit)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoHash(ctx context.Context, key string, members ...string) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.GeoHash(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoPos(ctx context.Context, key string, members ...string) *goredis.GeoPosCmd {
+	cmd := cmdBuilder.GeoPos(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoRadius(ctx context.Context, key string, longitude, latitude float64, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd {
+	cmd := cmdBuilder.GeoRadius(key, longitude, latitude, query)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GeoRadiusByMember(ctx context.Context, key, member string, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd {
+	cmd := cmdBuilder.GeoRadiusByMember(key, member, query)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Get(ctx context.Context, key string) *goredis.StringCmd {
+	cmd := cmdBuilder.Get(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GetBit(ctx context.Context, key string, offset int64) *goredis.IntCmd {
+	cmd := cmdBuilder.GetBit(key, offset)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GetRange(ctx context.Context, key string, start, end int64) *goredis.StringCmd {
+	cmd := cmdBuilder.GetRange(key, start, end)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) GetSet(ctx context.Context, key string, value interface{}) *goredis.StringCmd {
+	cmd := cmdBuilder.GetSet(key, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HDel(ctx context.Context, key string, fields ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.HDel(key, fields...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HGet(ctx context.Context, key, field string) *goredis.StringCmd {
+	cmd := cmdBuilder.HGet(key, field)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HGetAll(ctx context.Context, key string) *goredis.StringStringMapCmd {
+	cmd := cmdBuilder.HGetAll(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HMGet(ctx context.Context, key string, fields ...string) *goredis.SliceCmd {
+	cmd := cmdBuilder.HMGet(key, fields...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HMSet(ctx context.Context, key string, fields map[string]interface{}) *goredis.StatusCmd {
+	cmd := cmdBuilder.HMSet(key, fields)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) HScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return c.scan(ctx, match, count, "hscan", key, cursor)
+}
+
+func (c contextClient) HSet(ctx context.Context, key, field string, value interface{}) *goredis.BoolCmd {
+	cmd := cmdBuilder.HSet(key, field, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Incr(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.Incr(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) IncrBy(ctx context.Context, key string, value int64) *goredis.IntCmd {
+	cmd := cmdBuilder.IncrBy(key, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LIndex(ctx context.Context, key string, index int64) *goredis.StringCmd {
+	cmd := cmdBuilder.LIndex(key, index)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LLen(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.LLen(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LPop(ctx context.Context, key string) *goredis.StringCmd {
+	cmd := cmdBuilder.LPop(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LPush(ctx context.Context, key string, values ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.LPush(key, values...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LRange(ctx context.Context, key string, start, stop int64) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.LRange(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LRem(ctx context.Context, key string, count int64, value interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.LRem(key, count, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) LTrim(ctx context.Context, key string, start, stop int64) *goredis.StatusCmd {
+	cmd := cmdBuilder.LTrim(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) MGet(ctx context.Context, keys ...string) *goredis.SliceCmd {
+	cmd := cmdBuilder.MGet(keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) MSet(ctx context.Context, pairs ...interface{}) *goredis.StatusCmd {
+	cmd := cmdBuilder.MSet(pairs...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PExpire(ctx context.Context, key string, expiration time.Duration) *goredis.BoolCmd {
+	cmd := cmdBuilder.PExpire(key, expiration)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PExpireAt(ctx context.Context, key string, tm time.Time) *goredis.BoolCmd {
+	cmd := cmdBuilder.PExpireAt(key, tm)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PFAdd(ctx context.Context, key string, els ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.PFAdd(key, els...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PFCount(ctx context.Context, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.PFCount(keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PFMerge(ctx context.Context, dest string, keys ...string) *goredis.StatusCmd {
+	cmd := cmdBuilder.PFMerge(dest, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Persist(ctx context.Context, key string) *goredis.BoolCmd {
+	cmd := cmdBuilder.Persist(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Ping(ctx context.Context) *goredis.StatusCmd {
+	cmd := cmdBuilder.Ping()
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Publish(ctx context.Context, channel string, message interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.Publish(channel, message)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) RPop(ctx context.Context, key string) *goredis.StringCmd {
+	cmd := cmdBuilder.RPop(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) RPopLPush(ctx context.Context, source string, destination string) *goredis.StringCmd {
+	cmd := cmdBuilder.RPopLPush(source, destination)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) RPush(ctx context.Context, key string, values ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.RPush(key, values...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Rename(ctx context.Context, key, newkey string) *goredis.StatusCmd {
+	cmd := cmdBuilder.Rename(key, newkey)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SAdd(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.SAdd(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SCard(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.SCard(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SIsMember(ctx context.Context, key string, member interface{}) *goredis.BoolCmd {
+	cmd := cmdBuilder.SIsMember(key, member)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SMembers(ctx context.Context, key string) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.SMembers(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SPopN(ctx context.Context, key string, count int64) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.SPopN(key, count)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SRem(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.SRem(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return c.scan(ctx, match, count, "sscan", key, cursor)
+}
+
+func (c contextClient) Scan(ctx context.Context, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return c.scan(ctx, match, count, "scan", cursor)
+}
+
+func (c contextClient) ScriptExists(ctx context.Context, scripts ...string) *goredis.BoolSliceCmd {
+	cmd := cmdBuilder.ScriptExists(scripts...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ScriptLoad(ctx context.Context, script string) *goredis.StringCmd {
+	cmd := cmdBuilder.ScriptLoad(script)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *goredis.StatusCmd {
+	cmd := cmdBuilder.Set(key, value, expiration)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SetBit(ctx context.Context, key string, offset int64, value int) *goredis.IntCmd {
+	cmd := cmdBuilder.SetBit(key, offset, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) *goredis.BoolCmd {
+	cmd := cmdBuilder.SetNX(key, value, expiration)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) SetRange(ctx context.Context, key string, offset int64, value string) *goredis.IntCmd {
+	cmd := cmdBuilder.SetRange(key, offset, value)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) StrLen(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.StrLen(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) TTL(ctx context.Context, key string) *goredis.DurationCmd {
+	cmd := cmdBuilder.TTL(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) PTTL(ctx context.Context, key string) *goredis.DurationCmd {
+	cmd := cmdBuilder.PTTL(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Type(ctx context.Context, key string) *goredis.StatusCmd {
+	cmd := cmdBuilder.Type(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) Unlink(ctx context.Context, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.Unlink(keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XAck(ctx context.Context, stream, group string, ids ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.XAck(stream, group, ids...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XAdd(ctx context.Context, a *goredis.XAddArgs) *goredis.StringCmd {
+	cmd := cmdBuilder.XAdd(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XClaim(ctx context.Context, a *goredis.XClaimArgs) *goredis.XMessageSliceCmd {
+	cmd := cmdBuilder.XClaim(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XClaimJustID(ctx context.Context, a *goredis.XClaimArgs) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.XClaimJustID(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XDel(ctx context.Context, stream string, ids ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.XDel(stream, ids...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XGroupCreate(ctx context.Context, stream, group, start string) *goredis.StatusCmd {
+	cmd := cmdBuilder.XGroupCreate(stream, group, start)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XGroupCreateMkStream(ctx context.Context, stream, group, start string) *goredis.StatusCmd {
+	cmd := cmdBuilder.XGroupCreateMkStream(stream, group, start)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XGroupDelConsumer(ctx context.Context, stream, group, consumer string) *goredis.IntCmd {
+	cmd := cmdBuilder.XGroupDelConsumer(stream, group, consumer)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XGroupDestroy(ctx context.Context, stream, group string) *goredis.IntCmd {
+	cmd := cmdBuilder.XGroupDestroy(stream, group)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XGroupSetID(ctx context.Context, stream, group, start string) *goredis.StatusCmd {
+	cmd := cmdBuilder.XGroupSetID(stream, group, start)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XLen(ctx context.Context, stream string) *goredis.IntCmd {
+	cmd := cmdBuilder.XLen(stream)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XPending(ctx context.Context, stream, group string) *goredis.XPendingCmd {
+	cmd := cmdBuilder.XPending(stream, group)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XPendingExt(ctx context.Context, a *goredis.XPendingExtArgs) *goredis.XPendingExtCmd {
+	cmd := cmdBuilder.XPendingExt(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XRange(ctx context.Context, stream, start, stop string) *goredis.XMessageSliceCmd {
+	cmd := cmdBuilder.XRange(stream, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XRead(ctx context.Context, a *goredis.XReadArgs) *goredis.XStreamSliceCmd {
+	cmd := cmdBuilder.XRead(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XReadGroup(ctx context.Context, a *goredis.XReadGroupArgs) *goredis.XStreamSliceCmd {
+	cmd := cmdBuilder.XReadGroup(a)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XTrim(ctx context.Context, key string, maxLen int64) *goredis.IntCmd {
+	cmd := cmdBuilder.XTrim(key, maxLen)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) XTrimApprox(ctx context.Context, key string, maxLen int64) *goredis.IntCmd {
+	cmd := cmdBuilder.XTrimApprox(key, maxLen)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZAdd(ctx context.Context, key string, members ...goredis.Z) *goredis.IntCmd {
+	cmd := cmdBuilder.ZAdd(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZCard(ctx context.Context, key string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZCard(key)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZCount(ctx context.Context, key, min, max string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZCount(key, min, max)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) *goredis.FloatCmd {
+	cmd := cmdBuilder.ZIncrBy(key, increment, member)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZInterStore(ctx context.Context, destination string, store goredis.ZStore, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZInterStore(destination, store, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZPopMax(ctx context.Context, key string, count ...int64) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZPopMax(key, count...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZPopMin(ctx context.Context, key string, count ...int64) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZPopMin(key, count...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRange(ctx context.Context, key string, start, stop int64) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.ZRange(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRangeByScore(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.ZRangeByScore(key, opt)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRangeByScoreWithScores(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZRangeByScoreWithScores(key, opt)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRangeWithScores(ctx context.Context, key string, start, stop int64) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZRangeWithScores(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRank(ctx context.Context, key, member string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZRank(key, member)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRem(ctx context.Context, key string, members ...interface{}) *goredis.IntCmd {
+	cmd := cmdBuilder.ZRem(key, members...)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRemRangeByRank(ctx context.Context, key string, start, stop int64) *goredis.IntCmd {
+	cmd := cmdBuilder.ZRemRangeByRank(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRemRangeByScore(ctx context.Context, key, min, max string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZRemRangeByScore(key, min, max)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRevRangeByScore(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd {
+	cmd := cmdBuilder.ZRevRangeByScore(key, opt)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRevRangeByScoreWithScores(ctx context.Context, key string, opt goredis.ZRangeBy) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZRevRangeByScoreWithScores(key, opt)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRevRangeWithScores(ctx context.Context, key string, start, stop int64) *goredis.ZSliceCmd {
+	cmd := cmdBuilder.ZRevRangeWithScores(key, start, stop)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZRevRank(ctx context.Context, key, member string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZRevRank(key, member)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZScan(ctx context.Context, key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return c.scan(ctx, match, count, "zscan", key, cursor)
+}
+
+func (c contextClient) ZScore(ctx context.Context, key, member string) *goredis.FloatCmd {
+	cmd := cmdBuilder.ZScore(key, member)
+	c.process(ctx, cmd)
+	return cmd
+}
+
+func (c contextClient) ZUnionStore(ctx context.Context, dest string, store goredis.ZStore, keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.ZUnionStore(dest, store, keys...)
+	c.process(ctx, cmd)
+	return cmd
+}
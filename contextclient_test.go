@@ -0,0 +1,179 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+	"github.com/topfreegames/go-extensions-redis/mocks"
+)
+
+var _ redis.ContextClient = (*mocks.MockContextClient)(nil)
+var _ redis.ContextMux = (*mocks.MockContextMux)(nil)
+
+func TestContextClient(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	ctx := context.Background()
+	c := redis.NewContextLockerClient(client)
+	assert.Nil(t, c.Set(ctx, "key", "value", 0).Err())
+	assert.Equal(t, "value", c.Get(ctx, "key").Val())
+	_, err = c.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Incr("counter")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", c.Get(ctx, "counter").Val())
+	lock, err := c.Obtain(ctx, "lock", time.Second, redis.LockOptions{})
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = c.Obtain(ctx, "lock", time.Second, redis.LockOptions{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Nil(t, lock.Release())
+	assert.Equal(t, client, c.Client())
+}
+
+func TestContextMux(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	})
+	assert.Nil(t, err)
+	ctx := context.Background()
+	m := redis.NewContextMux(mux)
+	hash := redis.Hash("some_hash")
+	assert.Nil(t, m.On(ctx, hash).Set(ctx, "key", "value", 0).Err())
+	assert.Equal(t, "value", m.On(ctx, hash).Get(ctx, "key").Val())
+	assert.Len(t, m.All(ctx), 1)
+	assert.Nil(t, m.Invalidate(ctx, hash))
+}
+
+func TestContextClient_TracesUnderCallContext(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	c := redis.NewContextClient(client)
+	ctx, parent := recorder.StartSpan(context.Background(), "parent", nil)
+	assert.Nil(t, c.SAdd(ctx, "set", "a", "b").Err())
+	_, err = c.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Incr("counter")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, c.Watch(ctx, func(tx *goredis.Tx) error {
+		return tx.Get("counter").Err()
+	}, "counter"))
+	var members []string
+	iter := c.SScan(ctx, "set", 0, "", 1).Iterator()
+	for iter.Next() {
+		members = append(members, iter.Val())
+	}
+	assert.Nil(t, iter.Err())
+	assert.ElementsMatch(t, []string{"a", "b"}, members)
+	parent.End()
+	spans := recorder.Ended()
+	names := make([]string, 0, len(spans))
+	for _, span := range spans[:len(spans)-1] {
+		names = append(names, span.Name)
+		assert.Equal(t, spans[len(spans)-1], span.Parent, span.Name)
+	}
+	assert.Contains(t, names, "redis sadd")
+	assert.Contains(t, names, "redis pipe")
+	assert.Contains(t, names, "redis get")
+	assert.Contains(t, names, "redis sscan")
+	recorder.Reset()
+	assert.Nil(t, client.Get("counter").Err())
+	spans = recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Nil(t, spans[0].Parent)
+}
+
+func TestContextClient_ErrClient(t *testing.T) {
+	c := redis.NewContextClient(redis.NewErrClient(redis.ErrShardUnknown))
+	ctx := context.Background()
+	assert.Equal(t, redis.ErrShardUnknown, c.Get(ctx, "key").Err())
+	assert.Equal(t, redis.ErrShardUnknown, c.Scan(ctx, 0, "", 0).Err())
+	cmds, err := c.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Incr("counter")
+		return nil
+	})
+	assert.Equal(t, redis.ErrShardUnknown, err)
+	assert.Len(t, cmds, 1)
+	assert.Equal(t, redis.ErrShardUnknown, cmds[0].Err())
+}
+
+func TestContextMux_WithLockOn(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+		LockOptions: &redis.LockOptions{
+			MinTime: time.Millisecond,
+			MaxTime: 10 * time.Millisecond,
+			Limit:   1000,
+		},
+	})
+	assert.Nil(t, err)
+	m := redis.NewContextMux(mux)
+	hash := redis.Hash("some_hash")
+	lock, err := m.Obtain(context.Background(), hash.String(), time.Second, redis.LockOptions{})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	called := false
+	err = m.WithLockOn(ctx, hash, func() { called = true })
+	var lockErr *redis.LockError
+	assert.True(t, errors.As(err, &lockErr))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.False(t, called)
+	_, err = m.Obtain(ctx, hash.String(), time.Second, redis.LockOptions{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	assert.Nil(t, lock.Release())
+	assert.Nil(t, m.WithLockOn(context.Background(), hash, func() { called = true }))
+	assert.True(t, called)
+}
+
+func TestContextMux_WithLockOn_NoLocks(t *testing.T) {
+	m := redis.NewContextMux(mocks.NewMockMux(nil))
+	err := m.WithLockOn(context.Background(), redis.Hash("some_hash"), func() {})
+	assert.True(t, errors.Is(err, redis.ErrInvalidOptions))
+	_, err = m.Obtain(context.Background(), "key", time.Second, redis.LockOptions{})
+	assert.True(t, errors.Is(err, redis.ErrInvalidOptions))
+}
+
+func TestContextLockerClient_Obtain_NoContextObtainer(t *testing.T) {
+	client := struct {
+		redis.Client
+		redis.Locker
+	}{mocks.NewMockClient(nil), mocks.NewMockLocker(nil)}
+	c := redis.NewContextLockerClient(client)
+	_, err := c.Obtain(context.Background(), "key", time.Second, redis.LockOptions{})
+	assert.True(t, errors.Is(err, redis.ErrInvalidOptions))
+}
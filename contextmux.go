@@ -0,0 +1,147 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// ContextMux is a Mux whose operations take their context as first argument,
+// returning ContextClient instead of Client
+type ContextMux interface {
+	All(ctx context.Context) []ContextClient
+	Invalidate(ctx context.Context, hash Hash) error
+	InvalidateMany(ctx context.Context, many ...Hash) error
+	Mux() Mux
+	// Obtain tries to hold a lock over `key` during `ttl` duration, retrying until `ctx` is done
+	Obtain(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error)
+	On(ctx context.Context, hash Hash) ContextClient
+	OnMany(ctx context.Context, hash Hash, many ...Hash) ContextClient
+	PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error)
+	Publish(ctx context.Context, hash Hash, channel string, message interface{}) *goredis.IntCmd
+	Subscribe(ctx context.Context, channels ...string) (*Subscription, error)
+	// WithLockOn runs `f` under a unique lock for `hash`, which stops being waited for once `ctx` is done
+	WithLockOn(ctx context.Context, hash Hash, f func()) error
+}
+
+// contextLockerMux is implemented by muxes locking under the context of each call, e.g. BaseMux
+type contextLockerMux interface {
+	ObtainContext(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error)
+	WithLockOnContext(ctx context.Context, hash Hash, f func()) error
+}
+
+// contextMux adapts a Mux to ContextMux. A *BaseMux runs each operation under
+// the operation context as is, other muxes run it on their WithContext copy
+type contextMux struct {
+	mux  Mux
+	base *BaseMux
+	all  []ContextClient
+}
+
+// NewContextMux returns a ContextMux running its operations through `mux`
+func NewContextMux(mux Mux) ContextMux {
+	m := contextMux{mux: mux}
+	if base, ok := mux.(*BaseMux); ok {
+		m.base = base
+		for _, client := range base.All() {
+			m.all = append(m.all, NewContextClient(client))
+		}
+	}
+	return m
+}
+
+// under returns a copy of the *BaseMux running HashClient operations and locks under `ctx`
+func (m contextMux) under(ctx context.Context) BaseMux {
+	base := *m.base
+	base.ctx = ctx
+	return base
+}
+
+// Mux returns the Mux adapted by m
+func (m contextMux) Mux() Mux {
+	return m.mux
+}
+
+func (m contextMux) All(ctx context.Context) []ContextClient {
+	if m.base != nil {
+		return m.all
+	}
+	all := m.mux.WithContext(ctx).All()
+	clients := make([]ContextClient, 0, len(all))
+	for _, client := range all {
+		clients = append(clients, NewContextClient(client))
+	}
+	return clients
+}
+
+func (m contextMux) Invalidate(ctx context.Context, hash Hash) error {
+	if m.base != nil {
+		return m.under(ctx).Invalidate(hash)
+	}
+	return m.mux.WithContext(ctx).Invalidate(hash)
+}
+
+func (m contextMux) InvalidateMany(ctx context.Context, many ...Hash) error {
+	if m.base != nil {
+		return m.under(ctx).InvalidateMany(many...)
+	}
+	return m.mux.WithContext(ctx).InvalidateMany(many...)
+}
+
+func (m contextMux) On(ctx context.Context, hash Hash) ContextClient {
+	if m.base != nil {
+		return NewContextClient(m.under(ctx).On(hash))
+	}
+	return NewContextClient(m.mux.WithContext(ctx).On(hash))
+}
+
+func (m contextMux) OnMany(ctx context.Context, hash Hash, many ...Hash) ContextClient {
+	if m.base != nil {
+		return NewContextClient(m.under(ctx).OnMany(hash, many...))
+	}
+	return NewContextClient(m.mux.WithContext(ctx).OnMany(hash, many...))
+}
+
+func (m contextMux) Publish(ctx context.Context, hash Hash, channel string, message interface{}) *goredis.IntCmd {
+	if m.base != nil {
+		return m.On(ctx, hash).Publish(ctx, channel, message)
+	}
+	return m.mux.WithContext(ctx).Publish(hash, channel, message)
+}
+
+func (m contextMux) Subscribe(ctx context.Context, channels ...string) (*Subscription, error) {
+	return m.mux.Subscribe(ctx, channels...)
+}
+
+func (m contextMux) PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return m.mux.PSubscribe(ctx, patterns...)
+}
+
+func (m contextMux) WithLockOn(ctx context.Context, hash Hash, f func()) error {
+	locker, err := m.locker()
+	if err != nil {
+		return err
+	}
+	return locker.WithLockOnContext(ctx, hash, f)
+}
+
+func (m contextMux) Obtain(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	locker, err := m.locker()
+	if err != nil {
+		return nil, err
+	}
+	return locker.ObtainContext(ctx, key, ttl, opt)
+}
+
+// locker returns the Mux adapted by m if it locks, failing with ErrInvalidOptions otherwise
+func (m contextMux) locker() (contextLockerMux, error) {
+	locker, ok := m.mux.(contextLockerMux)
+	if !ok {
+		return nil, wrapError(ErrInvalidOptions, fmt.Errorf("%T has no locks", m.mux))
+	}
+	return locker, nil
+}
+
+var _ contextLockerMux = BaseMux{}
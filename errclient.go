@@ -14,14 +14,64 @@ type ErrClient struct {
 	err error
 }
 
+// NewErrClient returns an ErrClient failing every operation with `err`
 func NewErrClient(err error) *ErrClient {
 	return &ErrClient{err: err}
 }
 
+// Err returns the error that caused this ErrClient, e.g. why Mux.On failed
+func (e ErrClient) Err() error {
+	return e.err
+}
+
+func (e ErrClient) Append(key, value string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) BLPop(timeout time.Duration, keys ...string) *goredis.StringSliceCmd {
 	return goredis.NewStringSliceResult(nil, e.err)
 }
 
+func (e ErrClient) BRPop(timeout time.Duration, keys ...string) *goredis.StringSliceCmd {
+	return goredis.NewStringSliceResult(nil, e.err)
+}
+
+func (e ErrClient) BRPopLPush(source, destination string, timeout time.Duration) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
+func (e ErrClient) BZPopMax(timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd {
+	cmd := cmdBuilder.BZPopMax(timeout, keys...)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) BZPopMin(timeout time.Duration, keys ...string) *goredis.ZWithKeyCmd {
+	cmd := cmdBuilder.BZPopMin(timeout, keys...)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) BitCount(key string, bitCount *goredis.BitCount) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) BitField(key string, args ...interface{}) *goredis.SliceCmd {
+	return goredis.NewSliceResult(nil, e.err)
+}
+
+func (e ErrClient) BitOpAnd(destKey string, keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) BitOpOr(destKey string, keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) BitPos(key string, bit int64, pos ...int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) Close() error {
 	return e.err
 }
@@ -30,6 +80,14 @@ func (e ErrClient) Context() context.Context {
 	return nil
 }
 
+func (e ErrClient) Decr(key string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) DecrBy(key string, decrement int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) Del(keys ...string) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
@@ -46,10 +104,56 @@ func (e ErrClient) Exists(keys ...string) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) Expire(key string, expiration time.Duration) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, e.err)
+}
+
+func (e ErrClient) ExpireAt(key string, tm time.Time) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, e.err)
+}
+
+func (e ErrClient) GeoAdd(key string, geoLocation ...*goredis.GeoLocation) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) GeoDist(key string, member1, member2, unit string) *goredis.FloatCmd {
+	return goredis.NewFloatResult(0, e.err)
+}
+
+func (e ErrClient) GeoHash(key string, members ...string) *goredis.StringSliceCmd {
+	return goredis.NewStringSliceResult(nil, e.err)
+}
+
+func (e ErrClient) GeoPos(key string, members ...string) *goredis.GeoPosCmd {
+	cmd := cmdBuilder.GeoPos(key, members...)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) GeoRadius(key string, longitude, latitude float64, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd {
+	return goredis.NewGeoLocationCmdResult(nil, e.err)
+}
+
+func (e ErrClient) GeoRadiusByMember(key, member string, query *goredis.GeoRadiusQuery) *goredis.GeoLocationCmd {
+	return goredis.NewGeoLocationCmdResult(nil, e.err)
+}
+
 func (e ErrClient) Get(key string) *goredis.StringCmd {
 	return goredis.NewStringResult("", e.err)
 }
 
+func (e ErrClient) GetBit(key string, offset int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) GetRange(key string, start, end int64) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
+func (e ErrClient) GetSet(key string, value interface{}) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
 func (e ErrClient) HDel(key string, fields ...string) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
@@ -70,18 +174,50 @@ func (e ErrClient) HMSet(string, map[string]interface{}) *goredis.StatusCmd {
 	return goredis.NewStatusResult("", e.err)
 }
 
+func (e ErrClient) HScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return goredis.NewScanCmdResult(nil, 0, e.err)
+}
+
 func (e ErrClient) HSet(key, field string, value interface{}) *goredis.BoolCmd {
 	return goredis.NewBoolResult(false, e.err)
 }
 
+func (e ErrClient) Incr(key string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) IncrBy(key string, value int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) LIndex(key string, index int64) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
+func (e ErrClient) LLen(key string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) LPop(key string) *goredis.StringCmd {
 	return goredis.NewStringResult("", e.err)
 }
 
+func (e ErrClient) LPush(key string, values ...interface{}) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) LRange(key string, start, stop int64) *goredis.StringSliceCmd {
 	return goredis.NewStringSliceResult(nil, e.err)
 }
 
+func (e ErrClient) LRem(key string, count int64, value interface{}) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) LTrim(key string, start, stop int64) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
 func (e ErrClient) MGet(keys ...string) *goredis.SliceCmd {
 	return goredis.NewSliceResult(nil, e.err)
 }
@@ -94,10 +230,58 @@ func (e ErrClient) Options() *goredis.Options {
 	return nil
 }
 
+func (e ErrClient) PExpire(key string, expiration time.Duration) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, e.err)
+}
+
+func (e ErrClient) PExpireAt(key string, tm time.Time) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, e.err)
+}
+
+func (e ErrClient) PFAdd(key string, els ...interface{}) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) PFCount(keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) PFMerge(dest string, keys ...string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
+func (e ErrClient) PSubscribeContext(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return nil, e.err
+}
+
+func (e ErrClient) Persist(key string) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, e.err)
+}
+
 func (e ErrClient) Ping() *goredis.StatusCmd {
 	return goredis.NewStatusResult("", e.err)
 }
 
+func (e ErrClient) Pipeline() goredis.Pipeliner {
+	return NewErrPipeliner(e.err)
+}
+
+func (e ErrClient) Pipelined(fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error) {
+	return nil, e.err
+}
+
+func (e ErrClient) Process(cmd goredis.Cmder) error {
+	return failCmd(cmd, e.err)
+}
+
+func (e ErrClient) Publish(channel string, message interface{}) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) RPop(key string) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
 func (e ErrClient) RPopLPush(source string, destination string) *goredis.StringCmd {
 	return goredis.NewStringResult("", e.err)
 }
@@ -106,6 +290,10 @@ func (e ErrClient) RPush(key string, values ...interface{}) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) Rename(key, newkey string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
 func (e ErrClient) SAdd(key string, members ...interface{}) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
@@ -130,6 +318,14 @@ func (e ErrClient) SRem(key string, members ...interface{}) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) SScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return goredis.NewScanCmdResult(nil, 0, e.err)
+}
+
+func (e ErrClient) Scan(cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return goredis.NewScanCmdResult(nil, 0, e.err)
+}
+
 func (e ErrClient) ScriptExists(scripts ...string) *goredis.BoolSliceCmd {
 	return goredis.NewBoolSliceResult(nil, e.err)
 }
@@ -142,10 +338,26 @@ func (e ErrClient) Set(key string, value interface{}, expiration time.Duration)
 	return goredis.NewStatusResult("", e.err)
 }
 
+func (e ErrClient) SetBit(key string, offset int64, value int) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) SetNX(key string, value interface{}, expiration time.Duration) *goredis.BoolCmd {
 	return goredis.NewBoolResult(false, e.err)
 }
 
+func (e ErrClient) SetRange(key string, offset int64, value string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) StrLen(key string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) SubscribeContext(ctx context.Context, channels ...string) (*Subscription, error) {
+	return nil, e.err
+}
+
 func (e ErrClient) TTL(key string) *goredis.DurationCmd {
 	return goredis.NewDurationResult(0, e.err)
 }
@@ -155,14 +367,113 @@ func (e ErrClient) PTTL(key string) *goredis.DurationCmd {
 }
 
 func (e ErrClient) TxPipeline() goredis.Pipeliner {
-	// TODO: should be nil?
-	return nil
+	return NewErrPipeliner(e.err)
+}
+
+func (e ErrClient) TxPipelined(fn func(goredis.Pipeliner) error) ([]goredis.Cmder, error) {
+	return nil, e.err
+}
+
+func (e ErrClient) Type(key string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
+func (e ErrClient) Unlink(keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) Watch(fn func(*goredis.Tx) error, keys ...string) error {
+	return e.err
 }
 
 func (e *ErrClient) WithContext(context.Context) Client {
 	return e
 }
 
+func (e ErrClient) XAck(stream, group string, ids ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XAdd(a *goredis.XAddArgs) *goredis.StringCmd {
+	return goredis.NewStringResult("", e.err)
+}
+
+func (e ErrClient) XClaim(a *goredis.XClaimArgs) *goredis.XMessageSliceCmd {
+	cmd := cmdBuilder.XClaim(a)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XClaimJustID(a *goredis.XClaimArgs) *goredis.StringSliceCmd {
+	return goredis.NewStringSliceResult(nil, e.err)
+}
+
+func (e ErrClient) XDel(stream string, ids ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XGroupCreate(stream, group, start string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
+func (e ErrClient) XGroupCreateMkStream(stream, group, start string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
+func (e ErrClient) XGroupDelConsumer(stream, group, consumer string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XGroupDestroy(stream, group string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XGroupSetID(stream, group, start string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", e.err)
+}
+
+func (e ErrClient) XLen(stream string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XPending(stream, group string) *goredis.XPendingCmd {
+	cmd := cmdBuilder.XPending(stream, group)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XPendingExt(a *goredis.XPendingExtArgs) *goredis.XPendingExtCmd {
+	cmd := cmdBuilder.XPendingExt(a)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XRange(stream, start, stop string) *goredis.XMessageSliceCmd {
+	cmd := cmdBuilder.XRange(stream, start, stop)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XRead(a *goredis.XReadArgs) *goredis.XStreamSliceCmd {
+	cmd := cmdBuilder.XRead(a)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XReadGroup(a *goredis.XReadGroupArgs) *goredis.XStreamSliceCmd {
+	cmd := cmdBuilder.XReadGroup(a)
+	failCmd(cmd, e.err)
+	return cmd
+}
+
+func (e ErrClient) XTrim(key string, maxLen int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) XTrimApprox(key string, maxLen int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) ZAdd(key string, members ...goredis.Z) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
@@ -171,6 +482,30 @@ func (e ErrClient) ZCard(key string) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) ZCount(key, min, max string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) ZIncrBy(key string, increment float64, member string) *goredis.FloatCmd {
+	return goredis.NewFloatResult(0, e.err)
+}
+
+func (e ErrClient) ZInterStore(destination string, store goredis.ZStore, keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) ZPopMax(key string, count ...int64) *goredis.ZSliceCmd {
+	return goredis.NewZSliceCmdResult(nil, e.err)
+}
+
+func (e ErrClient) ZPopMin(key string, count ...int64) *goredis.ZSliceCmd {
+	return goredis.NewZSliceCmdResult(nil, e.err)
+}
+
+func (e ErrClient) ZRange(key string, start, stop int64) *goredis.StringSliceCmd {
+	return goredis.NewStringSliceResult(nil, e.err)
+}
+
 func (e ErrClient) ZRangeByScore(key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd {
 	return goredis.NewStringSliceResult(nil, e.err)
 }
@@ -191,6 +526,14 @@ func (e ErrClient) ZRem(key string, members ...interface{}) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) ZRemRangeByRank(key string, start, stop int64) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
+func (e ErrClient) ZRemRangeByScore(key, min, max string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
+
 func (e ErrClient) ZRevRangeByScore(key string, opt goredis.ZRangeBy) *goredis.StringSliceCmd {
 	return goredis.NewStringSliceResult(nil, e.err)
 }
@@ -207,6 +550,14 @@ func (e ErrClient) ZRevRank(key, member string) *goredis.IntCmd {
 	return goredis.NewIntResult(0, e.err)
 }
 
+func (e ErrClient) ZScan(key string, cursor uint64, match string, count int64) *goredis.ScanCmd {
+	return goredis.NewScanCmdResult(nil, 0, e.err)
+}
+
 func (e ErrClient) ZScore(key, member string) *goredis.FloatCmd {
 	return goredis.NewFloatResult(0, e.err)
 }
+
+func (e ErrClient) ZUnionStore(dest string, store goredis.ZStore, keys ...string) *goredis.IntCmd {
+	return goredis.NewIntResult(0, e.err)
+}
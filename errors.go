@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bsm/redislock"
+)
+
+var (
+	// ErrLockNotObtained is returned when a lock can't be obtained because
+	// it's being held by someone else
+	ErrLockNotObtained = redislock.ErrNotObtained
+	// ErrNotObtained is returned by Locker implementations when a lock
+	// can't be obtained because it's being held by someone else
+	//
+	// Deprecated: use ErrLockNotObtained
+	ErrNotObtained = ErrLockNotObtained
+	// ErrLockNotHeld is returned when releasing a lock that isn't held anymore
+	ErrLockNotHeld = redislock.ErrLockNotHeld
+	// ErrConnectTimeout is returned when Redis can't be reached before DialTimeout
+	ErrConnectTimeout = errors.New("timed out while waiting for Redis to connect")
+	// ErrInvalidOptions is returned when validating options fails
+	ErrInvalidOptions = errors.New("invalid options")
+	// ErrShardUnknown is returned when a Client isn't one of a Mux's shards
+	ErrShardUnknown = errors.New("unknown shard")
+)
+
+// wrappedError is an error that matches `kind` with errors.Is and unwraps to `cause`
+type wrappedError struct {
+	kind  error
+	cause error
+}
+
+func wrapError(kind, cause error) error {
+	return &wrappedError{kind: kind, cause: cause}
+}
+
+func (e *wrappedError) Error() string {
+	if e.cause == nil {
+		return e.kind.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.kind, e.cause)
+}
+
+func (e *wrappedError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *wrappedError) Unwrap() error {
+	return e.cause
+}
+
+// LockError is returned by Mux when a lock for Hash can't be obtained.
+// It unwraps to the reason it wasn't obtained, so errors.Is matches ErrLockNotObtained
+// only on contention, and e.g. context.DeadlineExceeded or ErrConnectTimeout otherwise
+type LockError struct {
+	Hash Hash
+	Err  error
+}
+
+func (e *LockError) Error() string {
+	return fmt.Sprintf("couldn't obtain lock for %v: %v", e.Hash, e.Err)
+}
+
+func (e *LockError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError is returned when validating a configuration field fails.
+// It matches ErrInvalidOptions with errors.Is and unwraps to the reason it's invalid
+type FieldError struct {
+	// Field is the path of the field as written in the configuration, e.g. clients[1].url,
+	// or the environment variable it was read from
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s: %v", ErrInvalidOptions, e.Field, e.Err)
+}
+
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidOptions
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,117 @@
+package redis
+
+import (
+	"context"
+	"sync"
+
+	goredis "github.com/go-redis/redis"
+)
+
+var _ goredis.Pipeliner = &ErrPipeliner{}
+
+// ErrPipeliner implements goredis.Pipeliner returning an error for each queued command and Exec
+type ErrPipeliner struct {
+	goredis.Cmdable
+	err error
+}
+
+// NewErrPipeliner returns an ErrPipeliner failing every command with `err`
+func NewErrPipeliner(err error) *ErrPipeliner {
+	return &ErrPipeliner{Cmdable: newErrCmdable(err), err: err}
+}
+
+// Err returns the error that caused this ErrPipeliner
+func (p ErrPipeliner) Err() error {
+	return p.err
+}
+
+func (p ErrPipeliner) Auth(password string) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", p.err)
+}
+
+func (p ErrPipeliner) Select(index int) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", p.err)
+}
+
+func (p ErrPipeliner) SwapDB(index1, index2 int) *goredis.StatusCmd {
+	return goredis.NewStatusResult("", p.err)
+}
+
+func (p ErrPipeliner) ClientSetName(name string) *goredis.BoolCmd {
+	return goredis.NewBoolResult(false, p.err)
+}
+
+func (p ErrPipeliner) Do(args ...interface{}) *goredis.Cmd {
+	return goredis.NewCmdResult(nil, p.err)
+}
+
+func (p ErrPipeliner) Process(cmd goredis.Cmder) error {
+	// fail `cmd` too, like the commands queued on p
+	return failCmd(cmd, p.err)
+}
+
+func (p ErrPipeliner) Close() error {
+	return p.err
+}
+
+func (p ErrPipeliner) Discard() error {
+	return p.err
+}
+
+func (p ErrPipeliner) Exec() ([]goredis.Cmder, error) {
+	return nil, p.err
+}
+
+// newErrCmdable returns a copy of cmdBuilder failing every command with `err`.
+// It shares the connection pool of cmdBuilder, which never connects anywhere
+func newErrCmdable(err error) *goredis.Client {
+	client := cmdBuilder.WithContext(context.Background())
+	client.WrapProcess(func(func(goredis.Cmder) error) func(goredis.Cmder) error {
+		return func(cmd goredis.Cmder) error {
+			return failCmd(cmd, err)
+		}
+	})
+	return client
+}
+
+// errFailer is a go-redis client failing every command with the error of its Limiter
+// without ever connecting anywhere, since go-redis checks its Limiter before getting
+// a connection. It sets the errors of commands whose results can't be built with
+// a goredis.NewXResult function, since their errors can't be set otherwise
+var errFailer = struct {
+	sync.Mutex
+	limiter *errLimiter
+	client  *goredis.Client
+}{limiter: &errLimiter{}}
+
+func init() {
+	errFailer.client = goredis.NewClient(&goredis.Options{
+		// no idle connections reaper
+		IdleTimeout: -1,
+	})
+	errFailer.client.SetLimiter(errFailer.limiter)
+}
+
+// failCmd fails `cmd` with `err`, returning `err`
+func failCmd(cmd goredis.Cmder, err error) error {
+	if err == nil {
+		// errFailer would connect
+		return nil
+	}
+	errFailer.Lock()
+	defer errFailer.Unlock()
+	errFailer.limiter.err = err
+	errFailer.client.Process(cmd)
+	return err
+}
+
+// errLimiter is a goredis.Limiter that never allows a command
+type errLimiter struct {
+	err error
+}
+
+func (l *errLimiter) Allow() error {
+	return l.err
+}
+
+func (l *errLimiter) ReportResult(result error) {}
@@ -0,0 +1,302 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+const (
+	// fairWaitTimeout is how long a fair lock waiter blocks waiting for a notification
+	// before trying again, in case a lock expired without being released
+	fairWaitTimeout = time.Second
+	// fairWaiterTTL is how long a waiter is kept in the queue without trying again,
+	// after that it's considered gone and skipped
+	fairWaiterTTL = 5 * fairWaitTimeout
+)
+
+// fairWaiting, if set, is called each time a waiter blocks waiting for a notification
+var fairWaiting func(key string)
+
+// fairHead is shared by the fair lock scripts: it sets `head` to the first waiter in the queue
+// KEYS[2] alive at `now`, per the zset KEYS[4] of waiters' deadlines, dropping the dead ones
+const fairHead = `
+local head = redis.call("zrange", KEYS[2], 0, 0)[1]
+while head do
+	local alive = redis.call("zscore", KEYS[4], head)
+	if alive and tonumber(alive) > now then
+		break
+	end
+	redis.call("zrem", KEYS[2], head)
+	redis.call("zrem", KEYS[4], head)
+	head = redis.call("zrange", KEYS[2], 0, 0)[1]
+end
+`
+
+// fairObtain enqueues the waiter ARGV[1] in the zset KEYS[2] (ordered by the sequence KEYS[3])
+// and hands it the lock KEYS[1] during ARGV[2] ms if it's the first alive waiter and the lock is free.
+// Waiters are alive until their deadline in KEYS[4], refreshed to ARGV[4] ms after ARGV[3] ms on each call
+var fairObtain = goredis.NewScript(`
+local now = tonumber(ARGV[3])
+if not redis.call("zscore", KEYS[2], ARGV[1]) then
+	redis.call("zadd", KEYS[2], redis.call("incr", KEYS[3]), ARGV[1])
+end
+redis.call("zadd", KEYS[4], now + tonumber(ARGV[4]), ARGV[1])
+redis.call("pexpire", KEYS[2], ARGV[4])
+redis.call("pexpire", KEYS[3], ARGV[4])
+redis.call("pexpire", KEYS[4], ARGV[4])
+` + fairHead + `
+if head == ARGV[1] and redis.call("exists", KEYS[1]) == 0 then
+	redis.call("set", KEYS[1], ARGV[1], "px", ARGV[2])
+	redis.call("zrem", KEYS[2], ARGV[1])
+	redis.call("zrem", KEYS[4], ARGV[1])
+	return 1
+end
+return 0
+`)
+
+// fairRelease frees the lock KEYS[1] if it's held by ARGV[1], returning the first waiter
+// alive at ARGV[2] ms to notify, or "" if there's none. Returns nil if the lock isn't held by ARGV[1]
+var fairRelease = goredis.NewScript(`
+if redis.call("get", KEYS[1]) ~= ARGV[1] then
+	return false
+end
+redis.call("del", KEYS[1])
+local now = tonumber(ARGV[2])
+` + fairHead + `
+return head or ""
+`)
+
+// fairLeave removes the waiter ARGV[1] and its notifications KEYS[5] from the queue, returning
+// the first waiter alive at ARGV[2] ms to notify if the lock is free, or "" otherwise
+var fairLeave = goredis.NewScript(`
+redis.call("zrem", KEYS[2], ARGV[1])
+redis.call("zrem", KEYS[4], ARGV[1])
+redis.call("del", KEYS[5])
+if redis.call("exists", KEYS[1]) == 1 then
+	return ""
+end
+local now = tonumber(ARGV[2])
+` + fairHead + `
+return head or ""
+`)
+
+// fairKeys are the keys used to hold a fair lock over `key`. They're all in the same hash
+// slot as `key` and passed to the scripts as KEYS, so they also run on Redis Cluster.
+// Waiters are alive until a deadline set by their own clock, so clocks should agree
+// to well within fairWaiterTTL
+type fairKeys struct {
+	lock         string
+	queue        string
+	seq          string
+	alive        string
+	notifyPrefix string
+}
+
+func newFairKeys(key string) fairKeys {
+	tagged := hashTagged(key)
+	return fairKeys{
+		lock:         key,
+		queue:        tagged + ":fair-queue",
+		seq:          tagged + ":fair-seq",
+		alive:        tagged + ":fair-alive",
+		notifyPrefix: tagged + ":fair-notify:",
+	}
+}
+
+// scriptKeys returns the keys of the fair lock scripts, with the notification list of `token` last
+func (k fairKeys) scriptKeys(token string) []string {
+	return []string{k.lock, k.queue, k.seq, k.alive, k.notify(token)}
+}
+
+// notify returns the list `token` waits on for notifications
+func (k fairKeys) notify(token string) string {
+	return k.notifyPrefix + token
+}
+
+// hashTagged returns a prefix for keys to hash to the same Redis Cluster slot as `key`.
+// Like Redis, only the first '{' and the first '}' after it can make a hash tag, and an
+// empty one doesn't count. So it's `key` if it has a hash tag, `key` as a hash tag if
+// it can be one, or else a hash tag of the slot of `key` followed by `key`
+func hashTagged(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key
+		}
+	}
+	if strings.IndexByte(key, '}') < 0 {
+		return "{" + key + "}"
+	}
+	// the whole key is hashed, but a '}' would end the hash tag
+	return "{" + slotTag(keySlot(key)) + "}" + key
+}
+
+// clusterSlots is the number of Redis Cluster hash slots
+const clusterSlots = 16384
+
+// keySlot returns the Redis Cluster hash slot of `key`, hashing all of it
+func keySlot(key string) int {
+	return int(crc16(key) % clusterSlots)
+}
+
+// slotTags are the smallest decimal numbers hashing to each slot, filled on first use
+var slotTags struct {
+	once sync.Once
+	tags [clusterSlots]string
+}
+
+// slotTag returns the smallest decimal number hashing to `slot`
+func slotTag(slot int) string {
+	slotTags.once.Do(func() {
+		for i, found := 0, 0; found < clusterSlots; i++ {
+			tag := strconv.Itoa(i)
+			if s := keySlot(tag); slotTags.tags[s] == "" {
+				slotTags.tags[s] = tag
+				found++
+			}
+		}
+	})
+	return slotTags.tags[slot]
+}
+
+// crc16 is the CRC16-CCITT (XMODEM) checksum Redis Cluster hashes keys by
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// notifyFair wakes up the waiter `head` returned by a fair lock script, if any
+func notifyFair(client goredis.Cmdable, keys fairKeys, head string) error {
+	if head == "" {
+		return nil
+	}
+	_, err := client.TxPipelined(func(pipe goredis.Pipeliner) error {
+		pipe.RPush(keys.notify(head), 1)
+		pipe.PExpire(keys.notify(head), fairWaiterTTL)
+		return nil
+	})
+	return err
+}
+
+// nowMillis returns the time in ms the fair lock scripts keep waiters alive by
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// fairLock is a Lock obtained in FIFO order among its waiters
+type fairLock struct {
+	client goredis.Cmdable
+	keys   fairKeys
+	token  string
+}
+
+// Release frees the lock and notifies the next waiter in the queue, if any
+func (l *fairLock) Release() error {
+	head, err := fairRelease.Run(l.client, l.keys.scriptKeys(l.token), l.token, nowMillis()).String()
+	if err == goredis.Nil {
+		return ErrLockNotHeld
+	}
+	if err != nil {
+		return err
+	}
+	return notifyFair(l.client, l.keys, head)
+}
+
+func (l *fairLock) releaseThrough(client goredis.Cmdable) error {
+	cpy := *l
+	cpy.client = client
+	return cpy.Release()
+}
+
+// obtainFair waits in a queue for a lock over `key`, so it's obtained in FIFO order among waiters.
+// Instead of polling, waiters are notified when the lock is released. When `ctx` is done the
+// waiter wakes itself up, so it doesn't keep blocking a connection after returning.
+// It waits until `deadline` (a zero `deadline` means no deadline), returning ErrLockNotObtained,
+// or until `ctx` is done, returning ctx.Err()
+func (c lockClient) obtainFair(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	deadline time.Time,
+	stats *lockStats,
+) (Lock, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	waitCtx := ctx
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	token, err := randomLockToken()
+	if err != nil {
+		return nil, err
+	}
+	keys := newFairKeys(key)
+	scriptKeys := keys.scriptKeys(token)
+	leave := func() {
+		if head, err := fairLeave.Run(c.client, scriptKeys, token, nowMillis()).String(); err == nil {
+			notifyFair(c.client, keys, head)
+		}
+	}
+	notifyKey := keys.notify(token)
+	for {
+		ok, err := fairObtain.Run(
+			c.client, scriptKeys,
+			token, ttl.Milliseconds(), nowMillis(), fairWaiterTTL.Milliseconds(),
+		).Int64()
+		if err != nil {
+			leave()
+			return nil, err
+		}
+		if ok == 1 {
+			c.client.Del(notifyKey)
+			return &fairLock{client: c.client, keys: keys, token: token}, nil
+		}
+		notified := make(chan struct{})
+		go func() {
+			defer close(notified)
+			c.client.BLPop(fairWaitTimeout, notifyKey)
+		}()
+		if fairWaiting != nil {
+			fairWaiting(key)
+		}
+		select {
+		case <-waitCtx.Done():
+			c.client.RPush(notifyKey, 1)
+			<-notified
+			leave()
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return nil, ErrLockNotObtained
+		case <-notified:
+			stats.retries++
+		}
+	}
+}
+
+func randomLockToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObtainFair_Order(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	// waiters also wait again on each timeout, so it's buffered
+	waiting := make(chan string, 100)
+	fairWaiting = func(key string) { waiting <- key }
+	defer func() { fairWaiting = nil }()
+	lockOpt := LockOptions{Fair: true}
+	lock, err := client.Obtain("some_key", time.Second, lockOpt)
+	assert.Nil(t, err)
+	var mutex sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			lock, err := client.ObtainContext(context.Background(), "some_key", time.Second, lockOpt)
+			assert.Nil(t, err)
+			mutex.Lock()
+			order = append(order, i)
+			mutex.Unlock()
+			assert.Nil(t, lock.Release())
+		}(i)
+		// waiters enqueue in order
+		assert.Equal(t, "some_key", <-waiting)
+	}
+	assert.Nil(t, lock.Release())
+	wg.Wait()
+	assert.Equal(t, []int{0, 1, 2}, order)
+}
+
+// clusterSlot returns the Redis Cluster hash slot of `key`, hashing its hash tag if it has one
+func clusterSlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return keySlot(key[start+1 : start+1+end])
+		}
+	}
+	return keySlot(key)
+}
+
+func TestKeySlot(t *testing.T) {
+	// as returned by CLUSTER KEYSLOT
+	assert.Equal(t, 12182, keySlot("foo"))
+	assert.Equal(t, 11058, keySlot("somekey"))
+	assert.Equal(t, clusterSlot("user1000"), clusterSlot("{user1000}.following"))
+}
+
+func TestFairKeys_SameSlot(t *testing.T) {
+	for key, tag := range map[string]string{"some_key": "{some_key}", "{user1}:lock": "{user1}"} {
+		keys := newFairKeys(key).scriptKeys("token")
+		assert.Equal(t, key, keys[0])
+		for _, k := range keys[1:] {
+			assert.Equal(t, tag, k[:strings.IndexByte(k, '}')+1], k)
+		}
+	}
+	// keys whose first hash tag is empty are hashed whole, even if a later one isn't
+	for _, key := range []string{"some_key", "{user1}:lock", "a{b", "a}b", "a{}b", "{}x{y}", "x{}{y}"} {
+		for _, k := range newFairKeys(key).scriptKeys("token") {
+			assert.Equal(t, clusterSlot(key), clusterSlot(k), "%s: %s", key, k)
+		}
+	}
+}
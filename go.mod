@@ -1,6 +1,6 @@
 module github.com/topfreegames/go-extensions-redis
 
-go 1.12
+go 1.15
 
 require (
 	github.com/bsm/redislock v0.4.0
@@ -9,6 +9,11 @@ require (
 	github.com/onsi/ginkgo v1.10.1 // indirect
 	github.com/onsi/gomega v1.7.0 // indirect
 	github.com/opentracing/opentracing-go v1.1.0
-	github.com/stretchr/testify v1.4.0
+	github.com/prometheus/client_golang v1.7.1
+	github.com/stretchr/testify v1.7.0
 	github.com/topfreegames/go-extensions-tracing v1.0.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
+	gopkg.in/yaml.v2 v2.2.5
 )
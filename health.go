@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HealthChecker reports the health of the Redis instances it uses
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) HealthReport
+}
+
+// HealthReport is the health of a set of Redis instances
+type HealthReport struct {
+	// Healthy is true if all instances are healthy
+	Healthy  bool           `json:"healthy"`
+	Statuses []HealthStatus `json:"statuses"`
+}
+
+// HealthStatus is the health of a single Redis instance
+type HealthStatus struct {
+	// Addr is the address of the instance, as mapped by Mux
+	Addr string
+	// Latency is how long it took to PING the instance, or to give up on it
+	Latency time.Duration
+	// Err is why the instance is unhealthy, nil if it's healthy
+	Err error
+}
+
+// MarshalJSON encodes the HealthStatus with its latency in milliseconds
+func (s HealthStatus) MarshalJSON() ([]byte, error) {
+	status := struct {
+		Addr      string  `json:"addr"`
+		Healthy   bool    `json:"healthy"`
+		LatencyMs float64 `json:"latency_ms"`
+		Error     string  `json:"error,omitempty"`
+	}{
+		Addr:      s.Addr,
+		Healthy:   s.Err == nil,
+		LatencyMs: float64(s.Latency) / float64(time.Millisecond),
+	}
+	if s.Err != nil {
+		status.Error = s.Err.Error()
+	}
+	return json.Marshal(status)
+}
+
+// HealthCheck pings the instance of the client until `ctx` is done
+func (c BaseClient) HealthCheck(ctx context.Context) HealthReport {
+	return checkHealth(ctx, []Client{&c})
+}
+
+// HealthCheck pings the instances of HashClient and all clients concurrently,
+// each until `ctx` is done. Instances are reported once even if they're used by many clients
+func (m BaseMux) HealthCheck(ctx context.Context) HealthReport {
+	clients := make([]Client, 0, len(m.clients)+1)
+	seen := make(map[string]bool, len(m.clients)+1)
+	for _, client := range append([]Client{m.hashClient}, m.clients...) {
+		if addr := clientAddr(client); !seen[addr] {
+			seen[addr] = true
+			clients = append(clients, client)
+		}
+	}
+	return checkHealth(ctx, clients)
+}
+
+// checkHealth pings all `clients` concurrently until `ctx` is done
+func checkHealth(ctx context.Context, clients []Client) HealthReport {
+	report := HealthReport{Healthy: true, Statuses: make([]HealthStatus, len(clients))}
+	var wg sync.WaitGroup
+	wg.Add(len(clients))
+	for i, client := range clients {
+		go func(i int, client Client) {
+			defer wg.Done()
+			report.Statuses[i] = checkClientHealth(ctx, client)
+		}(i, client)
+	}
+	wg.Wait()
+	for _, status := range report.Statuses {
+		if status.Err != nil {
+			report.Healthy = false
+		}
+	}
+	return report
+}
+
+func checkClientHealth(ctx context.Context, client Client) HealthStatus {
+	status := HealthStatus{Addr: clientAddr(client)}
+	start := time.Now()
+	// go-redis commands can't be canceled, so the ping is left behind when ctx is done
+	done := make(chan error, 1)
+	go func() {
+		done <- ping(client)
+	}()
+	select {
+	case status.Err = <-done:
+	case <-ctx.Done():
+		status.Err = ctx.Err()
+	}
+	status.Latency = time.Since(start)
+	return status
+}
+
+// NewHealthHandler returns an http.Handler reporting the health checked by `checker`
+// as JSON, within `timeout`. It responds 200 if all instances are healthy, otherwise 503,
+// so it can be used for Kubernetes probes
+func NewHealthHandler(checker HealthChecker, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		report := checker.HealthCheck(ctx)
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(report)
+	})
+}
+
+var _ HealthChecker = (*BaseClient)(nil)
+var _ HealthChecker = (*BaseMux)(nil)
@@ -0,0 +1,75 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestMux_HealthCheck(t *testing.T) {
+	cli0opt, err := goredis.ParseURL("redis://localhost:6666")
+	cli0opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	cli1opt, err := goredis.ParseURL("redis://0.0.0.0:6666")
+	cli1opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client0, err := redis.NewClient(cli0opt)
+	assert.Nil(t, err)
+	client1, err := redis.NewClient(cli1opt)
+	assert.Nil(t, err)
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client0,
+		Clients:    []redis.Client{client0, client1},
+	})
+	assert.Nil(t, err)
+	report := mux.HealthCheck(context.Background())
+	assert.True(t, report.Healthy)
+	assert.Len(t, report.Statuses, 2)
+	assert.Equal(t, "localhost:6666", report.Statuses[0].Addr)
+	assert.Equal(t, "0.0.0.0:6666", report.Statuses[1].Addr)
+	assert.True(t, report.Statuses[1].Latency > 0)
+	client1.Close()
+	report = mux.HealthCheck(context.Background())
+	assert.False(t, report.Healthy)
+	assert.Nil(t, report.Statuses[0].Err)
+	assert.NotNil(t, report.Statuses[1].Err)
+}
+
+func TestNewHealthHandler(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	rec := httptest.NewRecorder()
+	redis.NewHealthHandler(client, time.Second).ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Healthy  bool
+		Statuses []map[string]interface{}
+	}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.True(t, body.Healthy)
+	assert.Equal(t, "localhost:6666", body.Statuses[0]["addr"])
+	rec = httptest.NewRecorder()
+	errClient := redis.NewErrClient(errors.New("down"))
+	redis.NewHealthHandler(healthCheckerFunc(func(ctx context.Context) redis.HealthReport {
+		return redis.HealthReport{Statuses: []redis.HealthStatus{{Err: errClient.Ping().Err()}}}
+	}), time.Second).ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"down"`)
+}
+
+type healthCheckerFunc func(ctx context.Context) redis.HealthReport
+
+func (f healthCheckerFunc) HealthCheck(ctx context.Context) redis.HealthReport {
+	return f(ctx)
+}
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// scanner runs a SCAN family command from `cursor` on a single client
+type scanner struct {
+	ctx  context.Context
+	scan func(cursor uint64) *goredis.ScanCmd
+}
+
+// Iterator walks through the results of SCAN family commands hiding cursor handling.
+// It stops early if the context of the client being scanned is done.
+// For HSCAN results are fields followed by their values and for ZSCAN members followed by their scores
+type Iterator struct {
+	scanners []scanner
+	cursor   uint64
+	started  bool
+	page     []string
+	val      string
+	err      error
+}
+
+// NewScanIterator iterates over the keys of `client` matching `match`,
+// asking for `count` keys at a time
+func NewScanIterator(client Client, match string, count int64) *Iterator {
+	return &Iterator{scanners: []scanner{keysScanner(client, match, count)}}
+}
+
+// NewMuxScanIterator iterates over the keys of every Client in mux.All() matching `match`,
+// asking for `count` keys at a time, one client after the other
+func NewMuxScanIterator(mux Mux, match string, count int64) *Iterator {
+	clients := mux.All()
+	scanners := make([]scanner, 0, len(clients))
+	for _, client := range clients {
+		scanners = append(scanners, keysScanner(client, match, count))
+	}
+	return &Iterator{scanners: scanners}
+}
+
+// NewHScanIterator iterates over the fields and values of the hash `key`
+func NewHScanIterator(client Client, key, match string, count int64) *Iterator {
+	return &Iterator{scanners: []scanner{{
+		ctx: client.Context(),
+		scan: func(cursor uint64) *goredis.ScanCmd {
+			return client.HScan(key, cursor, match, count)
+		},
+	}}}
+}
+
+// NewSScanIterator iterates over the members of the set `key`
+func NewSScanIterator(client Client, key, match string, count int64) *Iterator {
+	return &Iterator{scanners: []scanner{{
+		ctx: client.Context(),
+		scan: func(cursor uint64) *goredis.ScanCmd {
+			return client.SScan(key, cursor, match, count)
+		},
+	}}}
+}
+
+// NewZScanIterator iterates over the members and scores of the sorted set `key`
+func NewZScanIterator(client Client, key, match string, count int64) *Iterator {
+	return &Iterator{scanners: []scanner{{
+		ctx: client.Context(),
+		scan: func(cursor uint64) *goredis.ScanCmd {
+			return client.ZScan(key, cursor, match, count)
+		},
+	}}}
+}
+
+func keysScanner(client Client, match string, count int64) scanner {
+	return scanner{
+		ctx: client.Context(),
+		scan: func(cursor uint64) *goredis.ScanCmd {
+			return client.Scan(cursor, match, count)
+		},
+	}
+}
+
+// Next advances the iterator, returning false when it's over or failed (see Err)
+func (it *Iterator) Next() bool {
+	for it.err == nil {
+		if len(it.page) > 0 {
+			it.val = it.page[0]
+			it.page = it.page[1:]
+			return true
+		}
+		if len(it.scanners) == 0 {
+			return false
+		}
+		current := it.scanners[0]
+		if it.started && it.cursor == 0 {
+			// current client is over
+			it.scanners = it.scanners[1:]
+			it.started = false
+			continue
+		}
+		if current.ctx != nil {
+			if err := current.ctx.Err(); err != nil {
+				it.err = err
+				return false
+			}
+		}
+		it.page, it.cursor, it.err = current.scan(it.cursor).Result()
+		it.started = true
+	}
+	return false
+}
+
+// Val returns the current value of the iterator
+func (it *Iterator) Val() string {
+	return it.val
+}
+
+// Err returns the error that stopped the iterator, if any
+func (it *Iterator) Err() error {
+	return it.err
+}
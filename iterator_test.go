@@ -0,0 +1,84 @@
+package redis_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestScanIterator(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	assert.Nil(t, client.MSet("key_0", 0, "key_1", 1, "key_2", 2, "other", 3).Err())
+	it := redis.NewScanIterator(client, "key_*", 1)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Val())
+	}
+	assert.Nil(t, it.Err())
+	sort.Strings(keys)
+	assert.Equal(t, []string{"key_0", "key_1", "key_2"}, keys)
+}
+
+func TestScanIterator_SScan(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	assert.Nil(t, client.SAdd("some_set", "a", "b").Err())
+	it := redis.NewSScanIterator(client, "some_set", "", 10)
+	var members []string
+	for it.Next() {
+		members = append(members, it.Val())
+	}
+	assert.Nil(t, it.Err())
+	sort.Strings(members)
+	assert.Equal(t, []string{"a", "b"}, members)
+}
+
+func TestScanIterator_ContextDone(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it := redis.NewScanIterator(client.WithContext(ctx), "", 10)
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+}
+
+func TestMuxScanIterator(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, client.MSet("key_0", 0, "key_1", 1).Err())
+	it := redis.NewMuxScanIterator(mux, "key_*", 10)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Val())
+	}
+	assert.Nil(t, it.Err())
+	sort.Strings(keys)
+	assert.Equal(t, []string{"key_0", "key_1"}, keys)
+}
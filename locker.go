@@ -1,6 +1,8 @@
 package redis
 
 import (
+	"context"
+	"math/rand"
 	"time"
 
 	"github.com/bsm/redislock"
@@ -18,6 +20,15 @@ type Locker interface {
 	Obtain(key string, ttl time.Duration, opt LockOptions) (Lock, error)
 }
 
+// ContextObtainer is optionally implemented by a Locker that can wait for a lock until
+// a context is done, e.g. BaseClient and ClusterClient
+type ContextObtainer interface {
+	// ObtainContext tries to lock a resource referred by `key` during `ttl` duration,
+	// retrying until the lock is obtained or `ctx` is done.
+	// If `ctx` is done first, it's return should be (nil, ctx.Err())
+	ObtainContext(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error)
+}
+
 // LockOptions define available settings for Locker.Obtain
 type LockOptions struct {
 	// ExponentialBackoff MinTime retry
@@ -28,8 +39,15 @@ type LockOptions struct {
 	// Default: 64ms
 	MaxTime time.Duration
 	// ExponentialBackoff retry limit
+	// Not used by ObtainContext, which retries until its context is done
 	// Default: 4
 	Limit int
+	// Fair makes waiters obtain the lock in FIFO order, being notified when it's released
+	// instead of retrying with backoff, so MinTime, MaxTime and Limit aren't used.
+	// Obtain waits for at most the lock's TTL, ObtainContext until its context is done.
+	// All callers of a given key should agree on Fair, otherwise the unfair ones can cut the line
+	// Default: false
+	Fair bool
 }
 
 func DefaultLockOptions() LockOptions {
@@ -42,9 +60,78 @@ func DefaultLockOptions() LockOptions {
 
 func (l LockOptions) toRedisLockOptions() redislock.Options {
 	return redislock.Options{
-		RetryStrategy: redislock.LimitRetry(
-			redislock.ExponentialBackoff(l.MinTime, l.MaxTime),
-			l.Limit,
-		),
+		RetryStrategy: redislock.LimitRetry(l.backoff(), l.Limit),
+	}
+}
+
+// backoff is an ExponentialBackoff from MinTime to MaxTime, or their defaults if unset.
+// MaxTime is at least MinTime, since an ExponentialBackoff without it grows unbounded
+func (l LockOptions) backoff() redislock.RetryStrategy {
+	defaults := DefaultLockOptions()
+	if l.MinTime <= 0 {
+		l.MinTime = defaults.MinTime
+	}
+	if l.MaxTime <= 0 {
+		l.MaxTime = defaults.MaxTime
+	}
+	if l.MaxTime < l.MinTime {
+		l.MaxTime = l.MinTime
+	}
+	return redislock.ExponentialBackoff(l.MinTime, l.MaxTime)
+}
+
+// jitterRetryStrategy is an unlimited backoff where each backoff
+// is randomly picked between half and all of its value
+func (l LockOptions) jitterRetryStrategy() redislock.RetryStrategy {
+	return &jitterBackoff{strategy: l.backoff()}
+}
+
+type jitterBackoff struct {
+	strategy redislock.RetryStrategy
+}
+
+func (j *jitterBackoff) NextBackoff() time.Duration {
+	backoff := j.strategy.NextBackoff()
+	if backoff < 2 {
+		return backoff
+	}
+	half := int64(backoff / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryObtain calls `try` until it returns a lock or an error, `retry` gives up,
+// `deadline` passes or `ctx` is done. `try` must return (nil, nil) if the lock is
+// being held by someone else. A zero `deadline` means no deadline
+func retryObtain(
+	ctx context.Context,
+	retry redislock.RetryStrategy,
+	deadline time.Time,
+	try func() (Lock, error),
+) (Lock, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var timer *time.Timer
+	for deadline.IsZero() || time.Now().Before(deadline) {
+		lock, err := try()
+		if err != nil || lock != nil {
+			return lock, err
+		}
+		backoff := retry.NextBackoff()
+		if backoff < 1 {
+			break
+		}
+		if timer == nil {
+			timer = time.NewTimer(backoff)
+			defer timer.Stop()
+		} else {
+			timer.Reset(backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
 	}
+	return nil, ErrLockNotObtained
 }
@@ -0,0 +1,145 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/bsm/redislock"
+	goredis "github.com/go-redis/redis"
+)
+
+// LockMetrics receives observations about locks obtained through a Locker,
+// implement it to feed histograms and counters of your metrics backend
+type LockMetrics interface {
+	// ObserveLockWait is called after every lock acquisition attempt with how long it waited,
+	// how many times it retried and the error returned, if any
+	ObserveLockWait(key string, wait time.Duration, retries int, err error)
+	// ObserveLockHold is called when a lock is released with how long it was held
+	// and whether its TTL expired before the release
+	ObserveLockHold(key string, hold time.Duration, expired bool)
+}
+
+type noopLockMetrics struct{}
+
+func (noopLockMetrics) ObserveLockWait(string, time.Duration, int, error) {}
+
+func (noopLockMetrics) ObserveLockHold(string, time.Duration, bool) {}
+
+// lockStats accumulates data about a single lock acquisition
+type lockStats struct {
+	retries int
+}
+
+// countRetries wraps `retry` counting into `stats` the backoffs it allows
+func countRetries(retry redislock.RetryStrategy, stats *lockStats) redislock.RetryStrategy {
+	return &countingRetry{strategy: retry, stats: stats}
+}
+
+type countingRetry struct {
+	strategy redislock.RetryStrategy
+	stats    *lockStats
+}
+
+func (r *countingRetry) NextBackoff() time.Duration {
+	backoff := r.strategy.NextBackoff()
+	if backoff >= 1 {
+		r.stats.retries++
+	}
+	return backoff
+}
+
+// instrumentedLock reports to LockMetrics and traces how long a Lock is held
+type instrumentedLock struct {
+	Lock
+	ctx        context.Context
+	key        string
+	metrics    LockMetrics
+	obtainedAt time.Time
+	attrs      Attributes
+	ttl        time.Duration
+	under      func(ctx context.Context) goredis.Cmdable
+}
+
+// throughReleaser is a Lock that can be released through another client than
+// the one that obtained it, e.g. one tracing its commands under the release span
+type throughReleaser interface {
+	releaseThrough(client goredis.Cmdable) error
+}
+
+// Release frees the underlying Lock. The lock is considered expired if it was
+// held longer than its TTL or if the underlying Lock isn't held anymore
+func (l *instrumentedLock) Release() error {
+	hold := time.Since(l.obtainedAt)
+	return traceSpan(l.ctx, "redis release lock", l.attrs, func(ctx context.Context, span Span) error {
+		err := l.release(ctx)
+		expired := hold >= l.ttl || err == ErrLockNotHeld
+		span.SetAttribute("lock.hold_ms", hold.Milliseconds())
+		span.SetAttribute("lock.expired", expired)
+		l.metrics.ObserveLockHold(l.key, hold, expired)
+		return err
+	})
+}
+
+// release frees the underlying Lock through a client tracing under `ctx`, if it can
+func (l *instrumentedLock) release(ctx context.Context) error {
+	if releaser, ok := l.Lock.(throughReleaser); ok && ctx != nil && l.under != nil {
+		return releaser.releaseThrough(l.under(ctx))
+	}
+	return l.Lock.Release()
+}
+
+// traceObtain traces and reports to LockMetrics a lock acquisition made by `obtain`,
+// which receives the context of the span to trace the acquisition commands under
+func (c lockClient) traceObtain(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	obtain func(ctx context.Context, stats *lockStats) (Lock, error),
+) (Lock, error) {
+	attrs := dbAttributes(c.addr, c.db)
+	metrics := c.getLockMetrics()
+	var lock Lock
+	start := time.Now()
+	err := traceSpan(ctx, "redis obtain lock", attrs, func(ctx context.Context, span Span) error {
+		var err error
+		stats := &lockStats{}
+		lock, err = obtain(ctx, stats)
+		wait := time.Since(start)
+		span.SetAttribute("lock.wait_ms", wait.Milliseconds())
+		span.SetAttribute("lock.retries", stats.retries)
+		metrics.ObserveLockWait(key, wait, stats.retries, err)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedLock{
+		Lock:       lock,
+		ctx:        ctx,
+		key:        key,
+		metrics:    metrics,
+		obtainedAt: time.Now(),
+		attrs:      attrs,
+		ttl:        ttl,
+		under:      c.under,
+	}, nil
+}
+
+// lockMetricsValue boxes LockMetrics in an atomic.Value, which can't hold nil
+type lockMetricsValue struct {
+	metrics LockMetrics
+}
+
+// SetLockMetrics sets the LockMetrics that observes locks obtained through this client
+// and every client returned by its WithContext, even those already returned.
+// It's safe to call while locks are obtained
+func (c *lockClient) SetLockMetrics(metrics LockMetrics) {
+	c.lockMetrics.Store(lockMetricsValue{metrics})
+}
+
+func (c lockClient) getLockMetrics() LockMetrics {
+	if v, ok := c.lockMetrics.Load().(lockMetricsValue); ok && v.metrics != nil {
+		return v.metrics
+	}
+	return noopLockMetrics{}
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// CommandMetrics receives observations about the commands run by clients, implement it
+// to feed histograms and counters of your metrics backend, e.g. PrometheusMetrics
+type CommandMetrics interface {
+	// ObserveCommand is called after every command with its name, the address and DB
+	// of the instance it ran on, how long it took and its error, if any.
+	// goredis.Nil isn't considered an error
+	ObserveCommand(command, addr string, db int, duration time.Duration, err error)
+	// ObservePipeline is called after every pipeline or transaction with how many
+	// commands it had, like ObserveCommand
+	ObservePipeline(commands int, addr string, db int, duration time.Duration, err error)
+}
+
+type noopCommandMetrics struct{}
+
+func (noopCommandMetrics) ObserveCommand(string, string, int, time.Duration, error) {}
+
+func (noopCommandMetrics) ObservePipeline(int, string, int, time.Duration, error) {}
+
+// commandMetricsValue boxes CommandMetrics in an atomic.Value, which can't hold nil
+type commandMetricsValue struct {
+	metrics CommandMetrics
+}
+
+// SetCommandMetrics sets the CommandMetrics that observes commands run through this client
+// and every client returned by its WithContext. It's safe to call while commands run
+func (c *BaseClient) SetCommandMetrics(metrics CommandMetrics) {
+	c.instrumentation.metrics.Store(commandMetricsValue{metrics})
+}
+
+// SetCommandMetrics sets the CommandMetrics that observes commands run through this client
+// and every client returned by its WithContext. It's safe to call while commands run
+func (c *ClusterClient) SetCommandMetrics(metrics CommandMetrics) {
+	c.instrumentation.metrics.Store(commandMetricsValue{metrics})
+}
+
+func makeMetricsMiddleware(
+	metrics func() CommandMetrics,
+	addr string,
+	db int,
+) func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+	return func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+		return func(cmd goredis.Cmder) error {
+			start := time.Now()
+			err := old(cmd)
+			metrics().ObserveCommand(cmd.Name(), addr, db, time.Since(start), observedError(err))
+			return err
+		}
+	}
+}
+
+func makeMetricsMiddlewarePipe(
+	metrics func() CommandMetrics,
+	addr string,
+	db int,
+) func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+	return func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+		return func(cmds []goredis.Cmder) error {
+			start := time.Now()
+			err := old(cmds)
+			metrics().ObservePipeline(len(cmds), addr, db, time.Since(start), observedError(err))
+			return err
+		}
+	}
+}
+
+// observedError returns `err` unless it's goredis.Nil, which means a missing key,
+// so it's neither observed by CommandMetrics nor recorded on spans as an error
+func observedError(err error) error {
+	if err == goredis.Nil {
+		return nil
+	}
+	return err
+}
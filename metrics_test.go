@@ -0,0 +1,127 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+type commandObservation struct {
+	command string
+	addr    string
+	db      int
+	err     error
+}
+
+type recordingMetrics struct {
+	mu        sync.Mutex
+	commands  []commandObservation
+	pipelines []int
+}
+
+func (m *recordingMetrics) ObserveCommand(command, addr string, db int, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.commands = append(m.commands, commandObservation{command, addr, db, err})
+}
+
+func (m *recordingMetrics) ObservePipeline(commands int, addr string, db int, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pipelines = append(m.pipelines, commands)
+}
+
+func TestBaseClient_SetCommandMetrics(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666/1")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	metrics := &recordingMetrics{}
+	client.SetCommandMetrics(metrics)
+	assert.Nil(t, client.Del("metrics_key").Err())
+	assert.Equal(t, goredis.Nil, client.Get("metrics_key").Err())
+	assert.Nil(t, client.Set("metrics_key", "value", 0).Err())
+	assert.NotNil(t, client.Incr("metrics_key").Err())
+	_, err = client.Pipelined(func(pipe goredis.Pipeliner) error {
+		pipe.Set("metrics_key", "value", 0)
+		pipe.Get("metrics_key")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, metrics.commands, 4)
+	assert.Equal(t, commandObservation{"del", "localhost:6666", 1, nil}, metrics.commands[0])
+	// a missing key isn't an error
+	assert.Equal(t, commandObservation{"get", "localhost:6666", 1, nil}, metrics.commands[1])
+	assert.Equal(t, "incr", metrics.commands[3].command)
+	assert.NotNil(t, metrics.commands[3].err)
+	assert.Equal(t, []int{2}, metrics.pipelines)
+}
+
+func TestBaseClient_SetCommandMetricsWhileRunning(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666/1")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	c := client.WithContext(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			assert.Nil(t, c.Set("metrics_key", "value", 0).Err())
+		}
+	}()
+	metrics := &recordingMetrics{}
+	client.SetCommandMetrics(metrics)
+	<-done
+	// copies returned before share it too
+	assert.Nil(t, c.Get("metrics_key").Err())
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	assert.Equal(t, "get", metrics.commands[len(metrics.commands)-1].command)
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	metrics := redis.NewPrometheusMetrics("test", nil)
+	registry := prometheus.NewPedanticRegistry()
+	assert.Nil(t, registry.Register(metrics))
+	metrics.ObserveCommand("get", "localhost:6666", 0, time.Millisecond, nil)
+	metrics.ObserveCommand("get", "localhost:6666", 0, time.Millisecond, errors.New("failed"))
+	metrics.ObservePipeline(3, "localhost:6666", 0, time.Millisecond, nil)
+	count, err := testutil.GatherAndCount(registry,
+		"test_redis_command_duration_seconds", "test_redis_pipeline_duration_seconds")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP test_redis_command_errors_total Redis commands that failed
+# TYPE test_redis_command_errors_total counter
+test_redis_command_errors_total{addr="localhost:6666",command="get",db="0"} 1
+`), "test_redis_command_errors_total", "test_redis_pipeline_errors_total"))
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP test_redis_pipeline_commands Number of commands of Redis pipelines and transactions
+# TYPE test_redis_pipeline_commands histogram
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="1"} 0
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="2"} 0
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="4"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="8"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="16"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="32"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="64"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="128"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="256"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="512"} 1
+test_redis_pipeline_commands_bucket{addr="localhost:6666",db="0",le="+Inf"} 1
+test_redis_pipeline_commands_sum{addr="localhost:6666",db="0"} 3
+test_redis_pipeline_commands_count{addr="localhost:6666",db="0"} 1
+`), "test_redis_pipeline_commands"))
+}
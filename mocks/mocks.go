@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/topfreegames/go-extensions-redis (interfaces: Client,Lock,Locker,Mux)
+// Source: github.com/topfreegames/go-extensions-redis (interfaces: Client,ContextClient,ContextLocker,ContextMux,ContextObtainer,Lock,Locker,LockerClient,Mux)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -36,6 +36,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// Append mocks base method
+func (m *MockClient) Append(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Append indicates an expected call of Append
+func (mr *MockClientMockRecorder) Append(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockClient)(nil).Append), arg0, arg1)
+}
+
 // BLPop mocks base method
 func (m *MockClient) BLPop(arg0 time.Duration, arg1 ...string) *redis.StringSliceCmd {
 	m.ctrl.T.Helper()
@@ -55,6 +69,167 @@ func (mr *MockClientMockRecorder) BLPop(arg0 interface{}, arg1 ...interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BLPop", reflect.TypeOf((*MockClient)(nil).BLPop), varargs...)
 }
 
+// BRPop mocks base method
+func (m *MockClient) BRPop(arg0 time.Duration, arg1 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BRPop", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// BRPop indicates an expected call of BRPop
+func (mr *MockClientMockRecorder) BRPop(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPop", reflect.TypeOf((*MockClient)(nil).BRPop), varargs...)
+}
+
+// BRPopLPush mocks base method
+func (m *MockClient) BRPopLPush(arg0, arg1 string, arg2 time.Duration) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BRPopLPush", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// BRPopLPush indicates an expected call of BRPopLPush
+func (mr *MockClientMockRecorder) BRPopLPush(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPopLPush", reflect.TypeOf((*MockClient)(nil).BRPopLPush), arg0, arg1, arg2)
+}
+
+// BZPopMax mocks base method
+func (m *MockClient) BZPopMax(arg0 time.Duration, arg1 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMax indicates an expected call of BZPopMax
+func (mr *MockClientMockRecorder) BZPopMax(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMax", reflect.TypeOf((*MockClient)(nil).BZPopMax), varargs...)
+}
+
+// BZPopMin mocks base method
+func (m *MockClient) BZPopMin(arg0 time.Duration, arg1 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMin indicates an expected call of BZPopMin
+func (mr *MockClientMockRecorder) BZPopMin(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMin", reflect.TypeOf((*MockClient)(nil).BZPopMin), varargs...)
+}
+
+// BitCount mocks base method
+func (m *MockClient) BitCount(arg0 string, arg1 *redis.BitCount) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BitCount", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitCount indicates an expected call of BitCount
+func (mr *MockClientMockRecorder) BitCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitCount", reflect.TypeOf((*MockClient)(nil).BitCount), arg0, arg1)
+}
+
+// BitField mocks base method
+func (m *MockClient) BitField(arg0 string, arg1 ...interface{}) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitField", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// BitField indicates an expected call of BitField
+func (mr *MockClientMockRecorder) BitField(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitField", reflect.TypeOf((*MockClient)(nil).BitField), varargs...)
+}
+
+// BitOpAnd mocks base method
+func (m *MockClient) BitOpAnd(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpAnd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpAnd indicates an expected call of BitOpAnd
+func (mr *MockClientMockRecorder) BitOpAnd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpAnd", reflect.TypeOf((*MockClient)(nil).BitOpAnd), varargs...)
+}
+
+// BitOpOr mocks base method
+func (m *MockClient) BitOpOr(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpOr", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpOr indicates an expected call of BitOpOr
+func (mr *MockClientMockRecorder) BitOpOr(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpOr", reflect.TypeOf((*MockClient)(nil).BitOpOr), varargs...)
+}
+
+// BitPos mocks base method
+func (m *MockClient) BitPos(arg0 string, arg1 int64, arg2 ...int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitPos", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitPos indicates an expected call of BitPos
+func (mr *MockClientMockRecorder) BitPos(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitPos", reflect.TypeOf((*MockClient)(nil).BitPos), varargs...)
+}
+
 // Close mocks base method
 func (m *MockClient) Close() error {
 	m.ctrl.T.Helper()
@@ -83,6 +258,34 @@ func (mr *MockClientMockRecorder) Context() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockClient)(nil).Context))
 }
 
+// Decr mocks base method
+func (m *MockClient) Decr(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decr", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Decr indicates an expected call of Decr
+func (mr *MockClientMockRecorder) Decr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decr", reflect.TypeOf((*MockClient)(nil).Decr), arg0)
+}
+
+// DecrBy mocks base method
+func (m *MockClient) DecrBy(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrBy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// DecrBy indicates an expected call of DecrBy
+func (mr *MockClientMockRecorder) DecrBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrBy", reflect.TypeOf((*MockClient)(nil).DecrBy), arg0, arg1)
+}
+
 // Del mocks base method
 func (m *MockClient) Del(arg0 ...string) *redis.IntCmd {
 	m.ctrl.T.Helper()
@@ -157,6 +360,133 @@ func (mr *MockClientMockRecorder) Exists(arg0 ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockClient)(nil).Exists), arg0...)
 }
 
+// Expire mocks base method
+func (m *MockClient) Expire(arg0 string, arg1 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire
+func (mr *MockClientMockRecorder) Expire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockClient)(nil).Expire), arg0, arg1)
+}
+
+// ExpireAt mocks base method
+func (m *MockClient) ExpireAt(arg0 string, arg1 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAt", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// ExpireAt indicates an expected call of ExpireAt
+func (mr *MockClientMockRecorder) ExpireAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAt", reflect.TypeOf((*MockClient)(nil).ExpireAt), arg0, arg1)
+}
+
+// GeoAdd mocks base method
+func (m *MockClient) GeoAdd(arg0 string, arg1 ...*redis.GeoLocation) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GeoAdd indicates an expected call of GeoAdd
+func (mr *MockClientMockRecorder) GeoAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoAdd", reflect.TypeOf((*MockClient)(nil).GeoAdd), varargs...)
+}
+
+// GeoDist mocks base method
+func (m *MockClient) GeoDist(arg0, arg1, arg2, arg3 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoDist", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// GeoDist indicates an expected call of GeoDist
+func (mr *MockClientMockRecorder) GeoDist(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoDist", reflect.TypeOf((*MockClient)(nil).GeoDist), arg0, arg1, arg2, arg3)
+}
+
+// GeoHash mocks base method
+func (m *MockClient) GeoHash(arg0 string, arg1 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoHash", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// GeoHash indicates an expected call of GeoHash
+func (mr *MockClientMockRecorder) GeoHash(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoHash", reflect.TypeOf((*MockClient)(nil).GeoHash), varargs...)
+}
+
+// GeoPos mocks base method
+func (m *MockClient) GeoPos(arg0 string, arg1 ...string) *redis.GeoPosCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoPos", varargs...)
+	ret0, _ := ret[0].(*redis.GeoPosCmd)
+	return ret0
+}
+
+// GeoPos indicates an expected call of GeoPos
+func (mr *MockClientMockRecorder) GeoPos(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoPos", reflect.TypeOf((*MockClient)(nil).GeoPos), varargs...)
+}
+
+// GeoRadius mocks base method
+func (m *MockClient) GeoRadius(arg0 string, arg1, arg2 float64, arg3 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadius", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadius indicates an expected call of GeoRadius
+func (mr *MockClientMockRecorder) GeoRadius(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadius", reflect.TypeOf((*MockClient)(nil).GeoRadius), arg0, arg1, arg2, arg3)
+}
+
+// GeoRadiusByMember mocks base method
+func (m *MockClient) GeoRadiusByMember(arg0, arg1 string, arg2 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadiusByMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadiusByMember indicates an expected call of GeoRadiusByMember
+func (mr *MockClientMockRecorder) GeoRadiusByMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadiusByMember", reflect.TypeOf((*MockClient)(nil).GeoRadiusByMember), arg0, arg1, arg2)
+}
+
 // Get mocks base method
 func (m *MockClient) Get(arg0 string) *redis.StringCmd {
 	m.ctrl.T.Helper()
@@ -171,6 +501,48 @@ func (mr *MockClientMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClient)(nil).Get), arg0)
 }
 
+// GetBit mocks base method
+func (m *MockClient) GetBit(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBit", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GetBit indicates an expected call of GetBit
+func (mr *MockClientMockRecorder) GetBit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBit", reflect.TypeOf((*MockClient)(nil).GetBit), arg0, arg1)
+}
+
+// GetRange mocks base method
+func (m *MockClient) GetRange(arg0 string, arg1, arg2 int64) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetRange indicates an expected call of GetRange
+func (mr *MockClientMockRecorder) GetRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockClient)(nil).GetRange), arg0, arg1, arg2)
+}
+
+// GetSet mocks base method
+func (m *MockClient) GetSet(arg0 string, arg1 interface{}) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSet", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetSet indicates an expected call of GetSet
+func (mr *MockClientMockRecorder) GetSet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSet", reflect.TypeOf((*MockClient)(nil).GetSet), arg0, arg1)
+}
+
 // HDel mocks base method
 func (m *MockClient) HDel(arg0 string, arg1 ...string) *redis.IntCmd {
 	m.ctrl.T.Helper()
@@ -251,6 +623,20 @@ func (mr *MockClientMockRecorder) HMSet(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMSet", reflect.TypeOf((*MockClient)(nil).HMSet), arg0, arg1)
 }
 
+// HScan mocks base method
+func (m *MockClient) HScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// HScan indicates an expected call of HScan
+func (mr *MockClientMockRecorder) HScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HScan", reflect.TypeOf((*MockClient)(nil).HScan), arg0, arg1, arg2, arg3)
+}
+
 // HSet mocks base method
 func (m *MockClient) HSet(arg0, arg1 string, arg2 interface{}) *redis.BoolCmd {
 	m.ctrl.T.Helper()
@@ -265,39 +651,142 @@ func (mr *MockClientMockRecorder) HSet(arg0, arg1, arg2 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockClient)(nil).HSet), arg0, arg1, arg2)
 }
 
-// LPop mocks base method
-func (m *MockClient) LPop(arg0 string) *redis.StringCmd {
+// Incr mocks base method
+func (m *MockClient) Incr(arg0 string) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LPop", arg0)
-	ret0, _ := ret[0].(*redis.StringCmd)
+	ret := m.ctrl.Call(m, "Incr", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// LPop indicates an expected call of LPop
-func (mr *MockClientMockRecorder) LPop(arg0 interface{}) *gomock.Call {
+// Incr indicates an expected call of Incr
+func (mr *MockClientMockRecorder) Incr(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPop", reflect.TypeOf((*MockClient)(nil).LPop), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockClient)(nil).Incr), arg0)
 }
 
-// LRange mocks base method
-func (m *MockClient) LRange(arg0 string, arg1, arg2 int64) *redis.StringSliceCmd {
+// IncrBy mocks base method
+func (m *MockClient) IncrBy(arg0 string, arg1 int64) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LRange", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	ret := m.ctrl.Call(m, "IncrBy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// LRange indicates an expected call of LRange
-func (mr *MockClientMockRecorder) LRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+// IncrBy indicates an expected call of IncrBy
+func (mr *MockClientMockRecorder) IncrBy(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRange", reflect.TypeOf((*MockClient)(nil).LRange), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*MockClient)(nil).IncrBy), arg0, arg1)
 }
 
-// MGet mocks base method
-func (m *MockClient) MGet(arg0 ...string) *redis.SliceCmd {
+// LIndex mocks base method
+func (m *MockClient) LIndex(arg0 string, arg1 int64) *redis.StringCmd {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
-	for _, a := range arg0 {
+	ret := m.ctrl.Call(m, "LIndex", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LIndex indicates an expected call of LIndex
+func (mr *MockClientMockRecorder) LIndex(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LIndex", reflect.TypeOf((*MockClient)(nil).LIndex), arg0, arg1)
+}
+
+// LLen mocks base method
+func (m *MockClient) LLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LLen indicates an expected call of LLen
+func (mr *MockClientMockRecorder) LLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LLen", reflect.TypeOf((*MockClient)(nil).LLen), arg0)
+}
+
+// LPop mocks base method
+func (m *MockClient) LPop(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LPop", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LPop indicates an expected call of LPop
+func (mr *MockClientMockRecorder) LPop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPop", reflect.TypeOf((*MockClient)(nil).LPop), arg0)
+}
+
+// LPush mocks base method
+func (m *MockClient) LPush(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LPush", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LPush indicates an expected call of LPush
+func (mr *MockClientMockRecorder) LPush(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPush", reflect.TypeOf((*MockClient)(nil).LPush), varargs...)
+}
+
+// LRange mocks base method
+func (m *MockClient) LRange(arg0 string, arg1, arg2 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// LRange indicates an expected call of LRange
+func (mr *MockClientMockRecorder) LRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRange", reflect.TypeOf((*MockClient)(nil).LRange), arg0, arg1, arg2)
+}
+
+// LRem mocks base method
+func (m *MockClient) LRem(arg0 string, arg1 int64, arg2 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LRem indicates an expected call of LRem
+func (mr *MockClientMockRecorder) LRem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRem", reflect.TypeOf((*MockClient)(nil).LRem), arg0, arg1, arg2)
+}
+
+// LTrim mocks base method
+func (m *MockClient) LTrim(arg0 string, arg1, arg2 int64) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LTrim", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// LTrim indicates an expected call of LTrim
+func (mr *MockClientMockRecorder) LTrim(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LTrim", reflect.TypeOf((*MockClient)(nil).LTrim), arg0, arg1, arg2)
+}
+
+// MGet mocks base method
+func (m *MockClient) MGet(arg0 ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "MGet", varargs...)
@@ -343,6 +832,110 @@ func (mr *MockClientMockRecorder) Options() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockClient)(nil).Options))
 }
 
+// PExpire mocks base method
+func (m *MockClient) PExpire(arg0 string, arg1 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpire", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpire indicates an expected call of PExpire
+func (mr *MockClientMockRecorder) PExpire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpire", reflect.TypeOf((*MockClient)(nil).PExpire), arg0, arg1)
+}
+
+// PExpireAt mocks base method
+func (m *MockClient) PExpireAt(arg0 string, arg1 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpireAt", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpireAt indicates an expected call of PExpireAt
+func (mr *MockClientMockRecorder) PExpireAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpireAt", reflect.TypeOf((*MockClient)(nil).PExpireAt), arg0, arg1)
+}
+
+// PFAdd mocks base method
+func (m *MockClient) PFAdd(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFAdd indicates an expected call of PFAdd
+func (mr *MockClientMockRecorder) PFAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFAdd", reflect.TypeOf((*MockClient)(nil).PFAdd), varargs...)
+}
+
+// PFCount mocks base method
+func (m *MockClient) PFCount(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFCount", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFCount indicates an expected call of PFCount
+func (mr *MockClientMockRecorder) PFCount(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFCount", reflect.TypeOf((*MockClient)(nil).PFCount), arg0...)
+}
+
+// PFMerge mocks base method
+func (m *MockClient) PFMerge(arg0 string, arg1 ...string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFMerge", varargs...)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// PFMerge indicates an expected call of PFMerge
+func (mr *MockClientMockRecorder) PFMerge(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFMerge", reflect.TypeOf((*MockClient)(nil).PFMerge), varargs...)
+}
+
+// PSubscribeContext mocks base method
+func (m *MockClient) PSubscribeContext(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PSubscribeContext", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PSubscribeContext indicates an expected call of PSubscribeContext
+func (mr *MockClientMockRecorder) PSubscribeContext(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PSubscribeContext", reflect.TypeOf((*MockClient)(nil).PSubscribeContext), varargs...)
+}
+
 // PTTL mocks base method
 func (m *MockClient) PTTL(arg0 string) *redis.DurationCmd {
 	m.ctrl.T.Helper()
@@ -357,6 +950,20 @@ func (mr *MockClientMockRecorder) PTTL(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PTTL", reflect.TypeOf((*MockClient)(nil).PTTL), arg0)
 }
 
+// Persist mocks base method
+func (m *MockClient) Persist(arg0 string) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Persist", arg0)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Persist indicates an expected call of Persist
+func (mr *MockClientMockRecorder) Persist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Persist", reflect.TypeOf((*MockClient)(nil).Persist), arg0)
+}
+
 // Ping mocks base method
 func (m *MockClient) Ping() *redis.StatusCmd {
 	m.ctrl.T.Helper()
@@ -371,6 +978,77 @@ func (mr *MockClientMockRecorder) Ping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockClient)(nil).Ping))
 }
 
+// Pipeline mocks base method
+func (m *MockClient) Pipeline() redis.Pipeliner {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipeline")
+	ret0, _ := ret[0].(redis.Pipeliner)
+	return ret0
+}
+
+// Pipeline indicates an expected call of Pipeline
+func (mr *MockClientMockRecorder) Pipeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipeline", reflect.TypeOf((*MockClient)(nil).Pipeline))
+}
+
+// Pipelined mocks base method
+func (m *MockClient) Pipelined(arg0 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipelined", arg0)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pipelined indicates an expected call of Pipelined
+func (mr *MockClientMockRecorder) Pipelined(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipelined", reflect.TypeOf((*MockClient)(nil).Pipelined), arg0)
+}
+
+// Process mocks base method
+func (m *MockClient) Process(arg0 redis.Cmder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Process indicates an expected call of Process
+func (mr *MockClientMockRecorder) Process(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockClient)(nil).Process), arg0)
+}
+
+// Publish mocks base method
+func (m *MockClient) Publish(arg0 string, arg1 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockClientMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockClient)(nil).Publish), arg0, arg1)
+}
+
+// RPop mocks base method
+func (m *MockClient) RPop(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RPop", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// RPop indicates an expected call of RPop
+func (mr *MockClientMockRecorder) RPop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPop", reflect.TypeOf((*MockClient)(nil).RPop), arg0)
+}
+
 // RPopLPush mocks base method
 func (m *MockClient) RPopLPush(arg0, arg1 string) *redis.StringCmd {
 	m.ctrl.T.Helper()
@@ -404,6 +1082,20 @@ func (mr *MockClientMockRecorder) RPush(arg0 interface{}, arg1 ...interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPush", reflect.TypeOf((*MockClient)(nil).RPush), varargs...)
 }
 
+// Rename mocks base method
+func (m *MockClient) Rename(arg0, arg1 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename
+func (mr *MockClientMockRecorder) Rename(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockClient)(nil).Rename), arg0, arg1)
+}
+
 // SAdd mocks base method
 func (m *MockClient) SAdd(arg0 string, arg1 ...interface{}) *redis.IntCmd {
 	m.ctrl.T.Helper()
@@ -494,147 +1186,5023 @@ func (m *MockClient) SRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
 // SRem indicates an expected call of SRem
 func (mr *MockClientMockRecorder) SRem(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockClient)(nil).SRem), varargs...)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockClient)(nil).SRem), varargs...)
+}
+
+// SScan mocks base method
+func (m *MockClient) SScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// SScan indicates an expected call of SScan
+func (mr *MockClientMockRecorder) SScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SScan", reflect.TypeOf((*MockClient)(nil).SScan), arg0, arg1, arg2, arg3)
+}
+
+// Scan mocks base method
+func (m *MockClient) Scan(arg0 uint64, arg1 string, arg2 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan
+func (mr *MockClientMockRecorder) Scan(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockClient)(nil).Scan), arg0, arg1, arg2)
+}
+
+// ScriptExists mocks base method
+func (m *MockClient) ScriptExists(arg0 ...string) *redis.BoolSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScriptExists", varargs...)
+	ret0, _ := ret[0].(*redis.BoolSliceCmd)
+	return ret0
+}
+
+// ScriptExists indicates an expected call of ScriptExists
+func (mr *MockClientMockRecorder) ScriptExists(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptExists", reflect.TypeOf((*MockClient)(nil).ScriptExists), arg0...)
+}
+
+// ScriptLoad mocks base method
+func (m *MockClient) ScriptLoad(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptLoad", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// ScriptLoad indicates an expected call of ScriptLoad
+func (mr *MockClientMockRecorder) ScriptLoad(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptLoad", reflect.TypeOf((*MockClient)(nil).ScriptLoad), arg0)
+}
+
+// Set mocks base method
+func (m *MockClient) Set(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Set indicates an expected call of Set
+func (mr *MockClientMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockClient)(nil).Set), arg0, arg1, arg2)
+}
+
+// SetBit mocks base method
+func (m *MockClient) SetBit(arg0 string, arg1 int64, arg2 int) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetBit indicates an expected call of SetBit
+func (mr *MockClientMockRecorder) SetBit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBit", reflect.TypeOf((*MockClient)(nil).SetBit), arg0, arg1, arg2)
+}
+
+// SetNX mocks base method
+func (m *MockClient) SetNX(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SetNX indicates an expected call of SetNX
+func (mr *MockClientMockRecorder) SetNX(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockClient)(nil).SetNX), arg0, arg1, arg2)
+}
+
+// SetRange mocks base method
+func (m *MockClient) SetRange(arg0 string, arg1 int64, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetRange indicates an expected call of SetRange
+func (mr *MockClientMockRecorder) SetRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRange", reflect.TypeOf((*MockClient)(nil).SetRange), arg0, arg1, arg2)
+}
+
+// StrLen mocks base method
+func (m *MockClient) StrLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// StrLen indicates an expected call of StrLen
+func (mr *MockClientMockRecorder) StrLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrLen", reflect.TypeOf((*MockClient)(nil).StrLen), arg0)
+}
+
+// SubscribeContext mocks base method
+func (m *MockClient) SubscribeContext(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeContext", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeContext indicates an expected call of SubscribeContext
+func (mr *MockClientMockRecorder) SubscribeContext(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeContext", reflect.TypeOf((*MockClient)(nil).SubscribeContext), varargs...)
+}
+
+// TTL mocks base method
+func (m *MockClient) TTL(arg0 string) *redis.DurationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL", arg0)
+	ret0, _ := ret[0].(*redis.DurationCmd)
+	return ret0
+}
+
+// TTL indicates an expected call of TTL
+func (mr *MockClientMockRecorder) TTL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockClient)(nil).TTL), arg0)
+}
+
+// TxPipeline mocks base method
+func (m *MockClient) TxPipeline() redis.Pipeliner {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipeline")
+	ret0, _ := ret[0].(redis.Pipeliner)
+	return ret0
+}
+
+// TxPipeline indicates an expected call of TxPipeline
+func (mr *MockClientMockRecorder) TxPipeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipeline", reflect.TypeOf((*MockClient)(nil).TxPipeline))
+}
+
+// TxPipelined mocks base method
+func (m *MockClient) TxPipelined(arg0 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipelined", arg0)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxPipelined indicates an expected call of TxPipelined
+func (mr *MockClientMockRecorder) TxPipelined(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipelined", reflect.TypeOf((*MockClient)(nil).TxPipelined), arg0)
+}
+
+// Type mocks base method
+func (m *MockClient) Type(arg0 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", arg0)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Type indicates an expected call of Type
+func (mr *MockClientMockRecorder) Type(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockClient)(nil).Type), arg0)
+}
+
+// Unlink mocks base method
+func (m *MockClient) Unlink(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlink", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink
+func (mr *MockClientMockRecorder) Unlink(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockClient)(nil).Unlink), arg0...)
+}
+
+// Watch mocks base method
+func (m *MockClient) Watch(arg0 func(*redis.Tx) error, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockClientMockRecorder) Watch(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockClient)(nil).Watch), varargs...)
+}
+
+// WithContext mocks base method
+func (m *MockClient) WithContext(arg0 context.Context) go_extensions_redis.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", arg0)
+	ret0, _ := ret[0].(go_extensions_redis.Client)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext
+func (mr *MockClientMockRecorder) WithContext(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockClient)(nil).WithContext), arg0)
+}
+
+// XAck mocks base method
+func (m *MockClient) XAck(arg0, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XAck", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XAck indicates an expected call of XAck
+func (mr *MockClientMockRecorder) XAck(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAck", reflect.TypeOf((*MockClient)(nil).XAck), varargs...)
+}
+
+// XAdd mocks base method
+func (m *MockClient) XAdd(arg0 *redis.XAddArgs) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XAdd", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// XAdd indicates an expected call of XAdd
+func (mr *MockClientMockRecorder) XAdd(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAdd", reflect.TypeOf((*MockClient)(nil).XAdd), arg0)
+}
+
+// XClaim mocks base method
+func (m *MockClient) XClaim(arg0 *redis.XClaimArgs) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaim", arg0)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XClaim indicates an expected call of XClaim
+func (mr *MockClientMockRecorder) XClaim(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaim", reflect.TypeOf((*MockClient)(nil).XClaim), arg0)
+}
+
+// XClaimJustID mocks base method
+func (m *MockClient) XClaimJustID(arg0 *redis.XClaimArgs) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaimJustID", arg0)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// XClaimJustID indicates an expected call of XClaimJustID
+func (mr *MockClientMockRecorder) XClaimJustID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaimJustID", reflect.TypeOf((*MockClient)(nil).XClaimJustID), arg0)
+}
+
+// XDel mocks base method
+func (m *MockClient) XDel(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XDel", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XDel indicates an expected call of XDel
+func (mr *MockClientMockRecorder) XDel(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XDel", reflect.TypeOf((*MockClient)(nil).XDel), varargs...)
+}
+
+// XGroupCreate mocks base method
+func (m *MockClient) XGroupCreate(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreate indicates an expected call of XGroupCreate
+func (mr *MockClientMockRecorder) XGroupCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreate", reflect.TypeOf((*MockClient)(nil).XGroupCreate), arg0, arg1, arg2)
+}
+
+// XGroupCreateMkStream mocks base method
+func (m *MockClient) XGroupCreateMkStream(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreateMkStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreateMkStream indicates an expected call of XGroupCreateMkStream
+func (mr *MockClientMockRecorder) XGroupCreateMkStream(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreateMkStream", reflect.TypeOf((*MockClient)(nil).XGroupCreateMkStream), arg0, arg1, arg2)
+}
+
+// XGroupDelConsumer mocks base method
+func (m *MockClient) XGroupDelConsumer(arg0, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDelConsumer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDelConsumer indicates an expected call of XGroupDelConsumer
+func (mr *MockClientMockRecorder) XGroupDelConsumer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDelConsumer", reflect.TypeOf((*MockClient)(nil).XGroupDelConsumer), arg0, arg1, arg2)
+}
+
+// XGroupDestroy mocks base method
+func (m *MockClient) XGroupDestroy(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDestroy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDestroy indicates an expected call of XGroupDestroy
+func (mr *MockClientMockRecorder) XGroupDestroy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDestroy", reflect.TypeOf((*MockClient)(nil).XGroupDestroy), arg0, arg1)
+}
+
+// XGroupSetID mocks base method
+func (m *MockClient) XGroupSetID(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupSetID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupSetID indicates an expected call of XGroupSetID
+func (mr *MockClientMockRecorder) XGroupSetID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupSetID", reflect.TypeOf((*MockClient)(nil).XGroupSetID), arg0, arg1, arg2)
+}
+
+// XLen mocks base method
+func (m *MockClient) XLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XLen indicates an expected call of XLen
+func (mr *MockClientMockRecorder) XLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XLen", reflect.TypeOf((*MockClient)(nil).XLen), arg0)
+}
+
+// XPending mocks base method
+func (m *MockClient) XPending(arg0, arg1 string) *redis.XPendingCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPending", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XPendingCmd)
+	return ret0
+}
+
+// XPending indicates an expected call of XPending
+func (mr *MockClientMockRecorder) XPending(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPending", reflect.TypeOf((*MockClient)(nil).XPending), arg0, arg1)
+}
+
+// XPendingExt mocks base method
+func (m *MockClient) XPendingExt(arg0 *redis.XPendingExtArgs) *redis.XPendingExtCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPendingExt", arg0)
+	ret0, _ := ret[0].(*redis.XPendingExtCmd)
+	return ret0
+}
+
+// XPendingExt indicates an expected call of XPendingExt
+func (mr *MockClientMockRecorder) XPendingExt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPendingExt", reflect.TypeOf((*MockClient)(nil).XPendingExt), arg0)
+}
+
+// XRange mocks base method
+func (m *MockClient) XRange(arg0, arg1, arg2 string) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XRange indicates an expected call of XRange
+func (mr *MockClientMockRecorder) XRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRange", reflect.TypeOf((*MockClient)(nil).XRange), arg0, arg1, arg2)
+}
+
+// XRead mocks base method
+func (m *MockClient) XRead(arg0 *redis.XReadArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRead", arg0)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XRead indicates an expected call of XRead
+func (mr *MockClientMockRecorder) XRead(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRead", reflect.TypeOf((*MockClient)(nil).XRead), arg0)
+}
+
+// XReadGroup mocks base method
+func (m *MockClient) XReadGroup(arg0 *redis.XReadGroupArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XReadGroup", arg0)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XReadGroup indicates an expected call of XReadGroup
+func (mr *MockClientMockRecorder) XReadGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XReadGroup", reflect.TypeOf((*MockClient)(nil).XReadGroup), arg0)
+}
+
+// XTrim mocks base method
+func (m *MockClient) XTrim(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XTrim", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XTrim indicates an expected call of XTrim
+func (mr *MockClientMockRecorder) XTrim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrim", reflect.TypeOf((*MockClient)(nil).XTrim), arg0, arg1)
+}
+
+// XTrimApprox mocks base method
+func (m *MockClient) XTrimApprox(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XTrimApprox", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XTrimApprox indicates an expected call of XTrimApprox
+func (mr *MockClientMockRecorder) XTrimApprox(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrimApprox", reflect.TypeOf((*MockClient)(nil).XTrimApprox), arg0, arg1)
+}
+
+// ZAdd mocks base method
+func (m *MockClient) ZAdd(arg0 string, arg1 ...redis.Z) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZAdd indicates an expected call of ZAdd
+func (mr *MockClientMockRecorder) ZAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZAdd", reflect.TypeOf((*MockClient)(nil).ZAdd), varargs...)
+}
+
+// ZCard mocks base method
+func (m *MockClient) ZCard(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZCard", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZCard indicates an expected call of ZCard
+func (mr *MockClientMockRecorder) ZCard(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCard", reflect.TypeOf((*MockClient)(nil).ZCard), arg0)
+}
+
+// ZCount mocks base method
+func (m *MockClient) ZCount(arg0, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZCount indicates an expected call of ZCount
+func (mr *MockClientMockRecorder) ZCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCount", reflect.TypeOf((*MockClient)(nil).ZCount), arg0, arg1, arg2)
+}
+
+// ZIncrBy mocks base method
+func (m *MockClient) ZIncrBy(arg0 string, arg1 float64, arg2 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZIncrBy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// ZIncrBy indicates an expected call of ZIncrBy
+func (mr *MockClientMockRecorder) ZIncrBy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZIncrBy", reflect.TypeOf((*MockClient)(nil).ZIncrBy), arg0, arg1, arg2)
+}
+
+// ZInterStore mocks base method
+func (m *MockClient) ZInterStore(arg0 string, arg1 redis.ZStore, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZInterStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZInterStore indicates an expected call of ZInterStore
+func (mr *MockClientMockRecorder) ZInterStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZInterStore", reflect.TypeOf((*MockClient)(nil).ZInterStore), varargs...)
+}
+
+// ZPopMax mocks base method
+func (m *MockClient) ZPopMax(arg0 string, arg1 ...int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZPopMax indicates an expected call of ZPopMax
+func (mr *MockClientMockRecorder) ZPopMax(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMax", reflect.TypeOf((*MockClient)(nil).ZPopMax), varargs...)
+}
+
+// ZPopMin mocks base method
+func (m *MockClient) ZPopMin(arg0 string, arg1 ...int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZPopMin indicates an expected call of ZPopMin
+func (mr *MockClientMockRecorder) ZPopMin(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMin", reflect.TypeOf((*MockClient)(nil).ZPopMin), varargs...)
+}
+
+// ZRange mocks base method
+func (m *MockClient) ZRange(arg0 string, arg1, arg2 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRange indicates an expected call of ZRange
+func (mr *MockClientMockRecorder) ZRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRange", reflect.TypeOf((*MockClient)(nil).ZRange), arg0, arg1, arg2)
+}
+
+// ZRangeByScore mocks base method
+func (m *MockClient) ZRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeByScore", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRangeByScore indicates an expected call of ZRangeByScore
+func (mr *MockClientMockRecorder) ZRangeByScore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScore", reflect.TypeOf((*MockClient)(nil).ZRangeByScore), arg0, arg1)
+}
+
+// ZRangeByScoreWithScores mocks base method
+func (m *MockClient) ZRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeByScoreWithScores", arg0, arg1)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRangeByScoreWithScores indicates an expected call of ZRangeByScoreWithScores
+func (mr *MockClientMockRecorder) ZRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScoreWithScores", reflect.TypeOf((*MockClient)(nil).ZRangeByScoreWithScores), arg0, arg1)
+}
+
+// ZRangeWithScores mocks base method
+func (m *MockClient) ZRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeWithScores", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRangeWithScores indicates an expected call of ZRangeWithScores
+func (mr *MockClientMockRecorder) ZRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeWithScores", reflect.TypeOf((*MockClient)(nil).ZRangeWithScores), arg0, arg1, arg2)
+}
+
+// ZRank mocks base method
+func (m *MockClient) ZRank(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRank", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRank indicates an expected call of ZRank
+func (mr *MockClientMockRecorder) ZRank(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRank", reflect.TypeOf((*MockClient)(nil).ZRank), arg0, arg1)
+}
+
+// ZRem mocks base method
+func (m *MockClient) ZRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZRem", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRem indicates an expected call of ZRem
+func (mr *MockClientMockRecorder) ZRem(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRem", reflect.TypeOf((*MockClient)(nil).ZRem), varargs...)
+}
+
+// ZRemRangeByRank mocks base method
+func (m *MockClient) ZRemRangeByRank(arg0 string, arg1, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByRank", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByRank indicates an expected call of ZRemRangeByRank
+func (mr *MockClientMockRecorder) ZRemRangeByRank(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByRank", reflect.TypeOf((*MockClient)(nil).ZRemRangeByRank), arg0, arg1, arg2)
+}
+
+// ZRemRangeByScore mocks base method
+func (m *MockClient) ZRemRangeByScore(arg0, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByScore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByScore indicates an expected call of ZRemRangeByScore
+func (mr *MockClientMockRecorder) ZRemRangeByScore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByScore", reflect.TypeOf((*MockClient)(nil).ZRemRangeByScore), arg0, arg1, arg2)
+}
+
+// ZRevRangeByScore mocks base method
+func (m *MockClient) ZRevRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeByScore", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRevRangeByScore indicates an expected call of ZRevRangeByScore
+func (mr *MockClientMockRecorder) ZRevRangeByScore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScore", reflect.TypeOf((*MockClient)(nil).ZRevRangeByScore), arg0, arg1)
+}
+
+// ZRevRangeByScoreWithScores mocks base method
+func (m *MockClient) ZRevRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeByScoreWithScores", arg0, arg1)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRevRangeByScoreWithScores indicates an expected call of ZRevRangeByScoreWithScores
+func (mr *MockClientMockRecorder) ZRevRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScoreWithScores", reflect.TypeOf((*MockClient)(nil).ZRevRangeByScoreWithScores), arg0, arg1)
+}
+
+// ZRevRangeWithScores mocks base method
+func (m *MockClient) ZRevRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeWithScores", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRevRangeWithScores indicates an expected call of ZRevRangeWithScores
+func (mr *MockClientMockRecorder) ZRevRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeWithScores", reflect.TypeOf((*MockClient)(nil).ZRevRangeWithScores), arg0, arg1, arg2)
+}
+
+// ZRevRank mocks base method
+func (m *MockClient) ZRevRank(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRank", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRevRank indicates an expected call of ZRevRank
+func (mr *MockClientMockRecorder) ZRevRank(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockClient)(nil).ZRevRank), arg0, arg1)
+}
+
+// ZScan mocks base method
+func (m *MockClient) ZScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// ZScan indicates an expected call of ZScan
+func (mr *MockClientMockRecorder) ZScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScan", reflect.TypeOf((*MockClient)(nil).ZScan), arg0, arg1, arg2, arg3)
+}
+
+// ZScore mocks base method
+func (m *MockClient) ZScore(arg0, arg1 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZScore", arg0, arg1)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// ZScore indicates an expected call of ZScore
+func (mr *MockClientMockRecorder) ZScore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScore", reflect.TypeOf((*MockClient)(nil).ZScore), arg0, arg1)
+}
+
+// ZUnionStore mocks base method
+func (m *MockClient) ZUnionStore(arg0 string, arg1 redis.ZStore, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZUnionStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZUnionStore indicates an expected call of ZUnionStore
+func (mr *MockClientMockRecorder) ZUnionStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZUnionStore", reflect.TypeOf((*MockClient)(nil).ZUnionStore), varargs...)
+}
+
+// MockContextClient is a mock of ContextClient interface
+type MockContextClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextClientMockRecorder
+}
+
+// MockContextClientMockRecorder is the mock recorder for MockContextClient
+type MockContextClientMockRecorder struct {
+	mock *MockContextClient
+}
+
+// NewMockContextClient creates a new mock instance
+func NewMockContextClient(ctrl *gomock.Controller) *MockContextClient {
+	mock := &MockContextClient{ctrl: ctrl}
+	mock.recorder = &MockContextClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContextClient) EXPECT() *MockContextClientMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method
+func (m *MockContextClient) Append(arg0 context.Context, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Append indicates an expected call of Append
+func (mr *MockContextClientMockRecorder) Append(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockContextClient)(nil).Append), arg0, arg1, arg2)
+}
+
+// BLPop mocks base method
+func (m *MockContextClient) BLPop(arg0 context.Context, arg1 time.Duration, arg2 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BLPop", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// BLPop indicates an expected call of BLPop
+func (mr *MockContextClientMockRecorder) BLPop(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BLPop", reflect.TypeOf((*MockContextClient)(nil).BLPop), varargs...)
+}
+
+// BRPop mocks base method
+func (m *MockContextClient) BRPop(arg0 context.Context, arg1 time.Duration, arg2 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BRPop", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// BRPop indicates an expected call of BRPop
+func (mr *MockContextClientMockRecorder) BRPop(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPop", reflect.TypeOf((*MockContextClient)(nil).BRPop), varargs...)
+}
+
+// BRPopLPush mocks base method
+func (m *MockContextClient) BRPopLPush(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BRPopLPush", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// BRPopLPush indicates an expected call of BRPopLPush
+func (mr *MockContextClientMockRecorder) BRPopLPush(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPopLPush", reflect.TypeOf((*MockContextClient)(nil).BRPopLPush), arg0, arg1, arg2, arg3)
+}
+
+// BZPopMax mocks base method
+func (m *MockContextClient) BZPopMax(arg0 context.Context, arg1 time.Duration, arg2 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMax indicates an expected call of BZPopMax
+func (mr *MockContextClientMockRecorder) BZPopMax(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMax", reflect.TypeOf((*MockContextClient)(nil).BZPopMax), varargs...)
+}
+
+// BZPopMin mocks base method
+func (m *MockContextClient) BZPopMin(arg0 context.Context, arg1 time.Duration, arg2 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMin indicates an expected call of BZPopMin
+func (mr *MockContextClientMockRecorder) BZPopMin(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMin", reflect.TypeOf((*MockContextClient)(nil).BZPopMin), varargs...)
+}
+
+// BitCount mocks base method
+func (m *MockContextClient) BitCount(arg0 context.Context, arg1 string, arg2 *redis.BitCount) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BitCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitCount indicates an expected call of BitCount
+func (mr *MockContextClientMockRecorder) BitCount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitCount", reflect.TypeOf((*MockContextClient)(nil).BitCount), arg0, arg1, arg2)
+}
+
+// BitField mocks base method
+func (m *MockContextClient) BitField(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitField", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// BitField indicates an expected call of BitField
+func (mr *MockContextClientMockRecorder) BitField(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitField", reflect.TypeOf((*MockContextClient)(nil).BitField), varargs...)
+}
+
+// BitOpAnd mocks base method
+func (m *MockContextClient) BitOpAnd(arg0 context.Context, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpAnd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpAnd indicates an expected call of BitOpAnd
+func (mr *MockContextClientMockRecorder) BitOpAnd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpAnd", reflect.TypeOf((*MockContextClient)(nil).BitOpAnd), varargs...)
+}
+
+// BitOpOr mocks base method
+func (m *MockContextClient) BitOpOr(arg0 context.Context, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpOr", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpOr indicates an expected call of BitOpOr
+func (mr *MockContextClientMockRecorder) BitOpOr(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpOr", reflect.TypeOf((*MockContextClient)(nil).BitOpOr), varargs...)
+}
+
+// BitPos mocks base method
+func (m *MockContextClient) BitPos(arg0 context.Context, arg1 string, arg2 int64, arg3 ...int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitPos", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitPos indicates an expected call of BitPos
+func (mr *MockContextClientMockRecorder) BitPos(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitPos", reflect.TypeOf((*MockContextClient)(nil).BitPos), varargs...)
+}
+
+// Client mocks base method
+func (m *MockContextClient) Client() go_extensions_redis.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Client")
+	ret0, _ := ret[0].(go_extensions_redis.Client)
+	return ret0
+}
+
+// Client indicates an expected call of Client
+func (mr *MockContextClientMockRecorder) Client() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Client", reflect.TypeOf((*MockContextClient)(nil).Client))
+}
+
+// Decr mocks base method
+func (m *MockContextClient) Decr(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decr", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Decr indicates an expected call of Decr
+func (mr *MockContextClientMockRecorder) Decr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decr", reflect.TypeOf((*MockContextClient)(nil).Decr), arg0, arg1)
+}
+
+// DecrBy mocks base method
+func (m *MockContextClient) DecrBy(arg0 context.Context, arg1 string, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrBy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// DecrBy indicates an expected call of DecrBy
+func (mr *MockContextClientMockRecorder) DecrBy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrBy", reflect.TypeOf((*MockContextClient)(nil).DecrBy), arg0, arg1, arg2)
+}
+
+// Del mocks base method
+func (m *MockContextClient) Del(arg0 context.Context, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Del", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Del indicates an expected call of Del
+func (mr *MockContextClientMockRecorder) Del(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockContextClient)(nil).Del), varargs...)
+}
+
+// Eval mocks base method
+func (m *MockContextClient) Eval(arg0 context.Context, arg1 string, arg2 []string, arg3 ...interface{}) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eval", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// Eval indicates an expected call of Eval
+func (mr *MockContextClientMockRecorder) Eval(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockContextClient)(nil).Eval), varargs...)
+}
+
+// EvalSha mocks base method
+func (m *MockContextClient) EvalSha(arg0 context.Context, arg1 string, arg2 []string, arg3 ...interface{}) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvalSha", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// EvalSha indicates an expected call of EvalSha
+func (mr *MockContextClientMockRecorder) EvalSha(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvalSha", reflect.TypeOf((*MockContextClient)(nil).EvalSha), varargs...)
+}
+
+// Exists mocks base method
+func (m *MockContextClient) Exists(arg0 context.Context, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exists", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockContextClientMockRecorder) Exists(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockContextClient)(nil).Exists), varargs...)
+}
+
+// Expire mocks base method
+func (m *MockContextClient) Expire(arg0 context.Context, arg1 string, arg2 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire
+func (mr *MockContextClientMockRecorder) Expire(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockContextClient)(nil).Expire), arg0, arg1, arg2)
+}
+
+// ExpireAt mocks base method
+func (m *MockContextClient) ExpireAt(arg0 context.Context, arg1 string, arg2 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// ExpireAt indicates an expected call of ExpireAt
+func (mr *MockContextClientMockRecorder) ExpireAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAt", reflect.TypeOf((*MockContextClient)(nil).ExpireAt), arg0, arg1, arg2)
+}
+
+// GeoAdd mocks base method
+func (m *MockContextClient) GeoAdd(arg0 context.Context, arg1 string, arg2 ...*redis.GeoLocation) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GeoAdd indicates an expected call of GeoAdd
+func (mr *MockContextClientMockRecorder) GeoAdd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoAdd", reflect.TypeOf((*MockContextClient)(nil).GeoAdd), varargs...)
+}
+
+// GeoDist mocks base method
+func (m *MockContextClient) GeoDist(arg0 context.Context, arg1, arg2, arg3, arg4 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoDist", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// GeoDist indicates an expected call of GeoDist
+func (mr *MockContextClientMockRecorder) GeoDist(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoDist", reflect.TypeOf((*MockContextClient)(nil).GeoDist), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GeoHash mocks base method
+func (m *MockContextClient) GeoHash(arg0 context.Context, arg1 string, arg2 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoHash", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// GeoHash indicates an expected call of GeoHash
+func (mr *MockContextClientMockRecorder) GeoHash(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoHash", reflect.TypeOf((*MockContextClient)(nil).GeoHash), varargs...)
+}
+
+// GeoPos mocks base method
+func (m *MockContextClient) GeoPos(arg0 context.Context, arg1 string, arg2 ...string) *redis.GeoPosCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoPos", varargs...)
+	ret0, _ := ret[0].(*redis.GeoPosCmd)
+	return ret0
+}
+
+// GeoPos indicates an expected call of GeoPos
+func (mr *MockContextClientMockRecorder) GeoPos(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoPos", reflect.TypeOf((*MockContextClient)(nil).GeoPos), varargs...)
+}
+
+// GeoRadius mocks base method
+func (m *MockContextClient) GeoRadius(arg0 context.Context, arg1 string, arg2, arg3 float64, arg4 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadius", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadius indicates an expected call of GeoRadius
+func (mr *MockContextClientMockRecorder) GeoRadius(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadius", reflect.TypeOf((*MockContextClient)(nil).GeoRadius), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GeoRadiusByMember mocks base method
+func (m *MockContextClient) GeoRadiusByMember(arg0 context.Context, arg1, arg2 string, arg3 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadiusByMember", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadiusByMember indicates an expected call of GeoRadiusByMember
+func (mr *MockContextClientMockRecorder) GeoRadiusByMember(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadiusByMember", reflect.TypeOf((*MockContextClient)(nil).GeoRadiusByMember), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method
+func (m *MockContextClient) Get(arg0 context.Context, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockContextClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockContextClient)(nil).Get), arg0, arg1)
+}
+
+// GetBit mocks base method
+func (m *MockContextClient) GetBit(arg0 context.Context, arg1 string, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GetBit indicates an expected call of GetBit
+func (mr *MockContextClientMockRecorder) GetBit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBit", reflect.TypeOf((*MockContextClient)(nil).GetBit), arg0, arg1, arg2)
+}
+
+// GetRange mocks base method
+func (m *MockContextClient) GetRange(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetRange indicates an expected call of GetRange
+func (mr *MockContextClientMockRecorder) GetRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockContextClient)(nil).GetRange), arg0, arg1, arg2, arg3)
+}
+
+// GetSet mocks base method
+func (m *MockContextClient) GetSet(arg0 context.Context, arg1 string, arg2 interface{}) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetSet indicates an expected call of GetSet
+func (mr *MockContextClientMockRecorder) GetSet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSet", reflect.TypeOf((*MockContextClient)(nil).GetSet), arg0, arg1, arg2)
+}
+
+// HDel mocks base method
+func (m *MockContextClient) HDel(arg0 context.Context, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HDel", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// HDel indicates an expected call of HDel
+func (mr *MockContextClientMockRecorder) HDel(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HDel", reflect.TypeOf((*MockContextClient)(nil).HDel), varargs...)
+}
+
+// HGet mocks base method
+func (m *MockContextClient) HGet(arg0 context.Context, arg1, arg2 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// HGet indicates an expected call of HGet
+func (mr *MockContextClientMockRecorder) HGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGet", reflect.TypeOf((*MockContextClient)(nil).HGet), arg0, arg1, arg2)
+}
+
+// HGetAll mocks base method
+func (m *MockContextClient) HGetAll(arg0 context.Context, arg1 string) *redis.StringStringMapCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGetAll", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringStringMapCmd)
+	return ret0
+}
+
+// HGetAll indicates an expected call of HGetAll
+func (mr *MockContextClientMockRecorder) HGetAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGetAll", reflect.TypeOf((*MockContextClient)(nil).HGetAll), arg0, arg1)
+}
+
+// HMGet mocks base method
+func (m *MockContextClient) HMGet(arg0 context.Context, arg1 string, arg2 ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HMGet", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// HMGet indicates an expected call of HMGet
+func (mr *MockContextClientMockRecorder) HMGet(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMGet", reflect.TypeOf((*MockContextClient)(nil).HMGet), varargs...)
+}
+
+// HMSet mocks base method
+func (m *MockContextClient) HMSet(arg0 context.Context, arg1 string, arg2 map[string]interface{}) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HMSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// HMSet indicates an expected call of HMSet
+func (mr *MockContextClientMockRecorder) HMSet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMSet", reflect.TypeOf((*MockContextClient)(nil).HMSet), arg0, arg1, arg2)
+}
+
+// HScan mocks base method
+func (m *MockContextClient) HScan(arg0 context.Context, arg1 string, arg2 uint64, arg3 string, arg4 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HScan", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// HScan indicates an expected call of HScan
+func (mr *MockContextClientMockRecorder) HScan(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HScan", reflect.TypeOf((*MockContextClient)(nil).HScan), arg0, arg1, arg2, arg3, arg4)
+}
+
+// HSet mocks base method
+func (m *MockContextClient) HSet(arg0 context.Context, arg1, arg2 string, arg3 interface{}) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HSet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// HSet indicates an expected call of HSet
+func (mr *MockContextClientMockRecorder) HSet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockContextClient)(nil).HSet), arg0, arg1, arg2, arg3)
+}
+
+// Incr mocks base method
+func (m *MockContextClient) Incr(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr
+func (mr *MockContextClientMockRecorder) Incr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockContextClient)(nil).Incr), arg0, arg1)
+}
+
+// IncrBy mocks base method
+func (m *MockContextClient) IncrBy(arg0 context.Context, arg1 string, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrBy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// IncrBy indicates an expected call of IncrBy
+func (mr *MockContextClientMockRecorder) IncrBy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*MockContextClient)(nil).IncrBy), arg0, arg1, arg2)
+}
+
+// LIndex mocks base method
+func (m *MockContextClient) LIndex(arg0 context.Context, arg1 string, arg2 int64) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LIndex", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LIndex indicates an expected call of LIndex
+func (mr *MockContextClientMockRecorder) LIndex(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LIndex", reflect.TypeOf((*MockContextClient)(nil).LIndex), arg0, arg1, arg2)
+}
+
+// LLen mocks base method
+func (m *MockContextClient) LLen(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LLen", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LLen indicates an expected call of LLen
+func (mr *MockContextClientMockRecorder) LLen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LLen", reflect.TypeOf((*MockContextClient)(nil).LLen), arg0, arg1)
+}
+
+// LPop mocks base method
+func (m *MockContextClient) LPop(arg0 context.Context, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LPop", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LPop indicates an expected call of LPop
+func (mr *MockContextClientMockRecorder) LPop(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPop", reflect.TypeOf((*MockContextClient)(nil).LPop), arg0, arg1)
+}
+
+// LPush mocks base method
+func (m *MockContextClient) LPush(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LPush", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LPush indicates an expected call of LPush
+func (mr *MockContextClientMockRecorder) LPush(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPush", reflect.TypeOf((*MockContextClient)(nil).LPush), varargs...)
+}
+
+// LRange mocks base method
+func (m *MockContextClient) LRange(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// LRange indicates an expected call of LRange
+func (mr *MockContextClientMockRecorder) LRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRange", reflect.TypeOf((*MockContextClient)(nil).LRange), arg0, arg1, arg2, arg3)
+}
+
+// LRem mocks base method
+func (m *MockContextClient) LRem(arg0 context.Context, arg1 string, arg2 int64, arg3 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LRem indicates an expected call of LRem
+func (mr *MockContextClientMockRecorder) LRem(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRem", reflect.TypeOf((*MockContextClient)(nil).LRem), arg0, arg1, arg2, arg3)
+}
+
+// LTrim mocks base method
+func (m *MockContextClient) LTrim(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LTrim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// LTrim indicates an expected call of LTrim
+func (mr *MockContextClientMockRecorder) LTrim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LTrim", reflect.TypeOf((*MockContextClient)(nil).LTrim), arg0, arg1, arg2, arg3)
+}
+
+// MGet mocks base method
+func (m *MockContextClient) MGet(arg0 context.Context, arg1 ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MGet", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// MGet indicates an expected call of MGet
+func (mr *MockContextClientMockRecorder) MGet(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockContextClient)(nil).MGet), varargs...)
+}
+
+// MSet mocks base method
+func (m *MockContextClient) MSet(arg0 context.Context, arg1 ...interface{}) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MSet", varargs...)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// MSet indicates an expected call of MSet
+func (mr *MockContextClientMockRecorder) MSet(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MSet", reflect.TypeOf((*MockContextClient)(nil).MSet), varargs...)
+}
+
+// PExpire mocks base method
+func (m *MockContextClient) PExpire(arg0 context.Context, arg1 string, arg2 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpire", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpire indicates an expected call of PExpire
+func (mr *MockContextClientMockRecorder) PExpire(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpire", reflect.TypeOf((*MockContextClient)(nil).PExpire), arg0, arg1, arg2)
+}
+
+// PExpireAt mocks base method
+func (m *MockContextClient) PExpireAt(arg0 context.Context, arg1 string, arg2 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpireAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpireAt indicates an expected call of PExpireAt
+func (mr *MockContextClientMockRecorder) PExpireAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpireAt", reflect.TypeOf((*MockContextClient)(nil).PExpireAt), arg0, arg1, arg2)
+}
+
+// PFAdd mocks base method
+func (m *MockContextClient) PFAdd(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFAdd indicates an expected call of PFAdd
+func (mr *MockContextClientMockRecorder) PFAdd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFAdd", reflect.TypeOf((*MockContextClient)(nil).PFAdd), varargs...)
+}
+
+// PFCount mocks base method
+func (m *MockContextClient) PFCount(arg0 context.Context, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFCount", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFCount indicates an expected call of PFCount
+func (mr *MockContextClientMockRecorder) PFCount(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFCount", reflect.TypeOf((*MockContextClient)(nil).PFCount), varargs...)
+}
+
+// PFMerge mocks base method
+func (m *MockContextClient) PFMerge(arg0 context.Context, arg1 string, arg2 ...string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFMerge", varargs...)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// PFMerge indicates an expected call of PFMerge
+func (mr *MockContextClientMockRecorder) PFMerge(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFMerge", reflect.TypeOf((*MockContextClient)(nil).PFMerge), varargs...)
+}
+
+// PSubscribe mocks base method
+func (m *MockContextClient) PSubscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PSubscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PSubscribe indicates an expected call of PSubscribe
+func (mr *MockContextClientMockRecorder) PSubscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PSubscribe", reflect.TypeOf((*MockContextClient)(nil).PSubscribe), varargs...)
+}
+
+// PTTL mocks base method
+func (m *MockContextClient) PTTL(arg0 context.Context, arg1 string) *redis.DurationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PTTL", arg0, arg1)
+	ret0, _ := ret[0].(*redis.DurationCmd)
+	return ret0
+}
+
+// PTTL indicates an expected call of PTTL
+func (mr *MockContextClientMockRecorder) PTTL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PTTL", reflect.TypeOf((*MockContextClient)(nil).PTTL), arg0, arg1)
+}
+
+// Persist mocks base method
+func (m *MockContextClient) Persist(arg0 context.Context, arg1 string) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Persist", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Persist indicates an expected call of Persist
+func (mr *MockContextClientMockRecorder) Persist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Persist", reflect.TypeOf((*MockContextClient)(nil).Persist), arg0, arg1)
+}
+
+// Ping mocks base method
+func (m *MockContextClient) Ping(arg0 context.Context) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping
+func (mr *MockContextClientMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockContextClient)(nil).Ping), arg0)
+}
+
+// Pipelined mocks base method
+func (m *MockContextClient) Pipelined(arg0 context.Context, arg1 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipelined", arg0, arg1)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pipelined indicates an expected call of Pipelined
+func (mr *MockContextClientMockRecorder) Pipelined(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipelined", reflect.TypeOf((*MockContextClient)(nil).Pipelined), arg0, arg1)
+}
+
+// Publish mocks base method
+func (m *MockContextClient) Publish(arg0 context.Context, arg1 string, arg2 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockContextClientMockRecorder) Publish(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockContextClient)(nil).Publish), arg0, arg1, arg2)
+}
+
+// RPop mocks base method
+func (m *MockContextClient) RPop(arg0 context.Context, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RPop", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// RPop indicates an expected call of RPop
+func (mr *MockContextClientMockRecorder) RPop(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPop", reflect.TypeOf((*MockContextClient)(nil).RPop), arg0, arg1)
+}
+
+// RPopLPush mocks base method
+func (m *MockContextClient) RPopLPush(arg0 context.Context, arg1, arg2 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RPopLPush", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// RPopLPush indicates an expected call of RPopLPush
+func (mr *MockContextClientMockRecorder) RPopLPush(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPopLPush", reflect.TypeOf((*MockContextClient)(nil).RPopLPush), arg0, arg1, arg2)
+}
+
+// RPush mocks base method
+func (m *MockContextClient) RPush(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RPush", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// RPush indicates an expected call of RPush
+func (mr *MockContextClientMockRecorder) RPush(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPush", reflect.TypeOf((*MockContextClient)(nil).RPush), varargs...)
+}
+
+// Rename mocks base method
+func (m *MockContextClient) Rename(arg0 context.Context, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename
+func (mr *MockContextClientMockRecorder) Rename(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockContextClient)(nil).Rename), arg0, arg1, arg2)
+}
+
+// SAdd mocks base method
+func (m *MockContextClient) SAdd(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SAdd indicates an expected call of SAdd
+func (mr *MockContextClientMockRecorder) SAdd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SAdd", reflect.TypeOf((*MockContextClient)(nil).SAdd), varargs...)
+}
+
+// SCard mocks base method
+func (m *MockContextClient) SCard(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SCard", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SCard indicates an expected call of SCard
+func (mr *MockContextClientMockRecorder) SCard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SCard", reflect.TypeOf((*MockContextClient)(nil).SCard), arg0, arg1)
+}
+
+// SIsMember mocks base method
+func (m *MockContextClient) SIsMember(arg0 context.Context, arg1 string, arg2 interface{}) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SIsMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SIsMember indicates an expected call of SIsMember
+func (mr *MockContextClientMockRecorder) SIsMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SIsMember", reflect.TypeOf((*MockContextClient)(nil).SIsMember), arg0, arg1, arg2)
+}
+
+// SMembers mocks base method
+func (m *MockContextClient) SMembers(arg0 context.Context, arg1 string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SMembers", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// SMembers indicates an expected call of SMembers
+func (mr *MockContextClientMockRecorder) SMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMembers", reflect.TypeOf((*MockContextClient)(nil).SMembers), arg0, arg1)
+}
+
+// SPopN mocks base method
+func (m *MockContextClient) SPopN(arg0 context.Context, arg1 string, arg2 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SPopN", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// SPopN indicates an expected call of SPopN
+func (mr *MockContextClientMockRecorder) SPopN(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SPopN", reflect.TypeOf((*MockContextClient)(nil).SPopN), arg0, arg1, arg2)
+}
+
+// SRem mocks base method
+func (m *MockContextClient) SRem(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SRem", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SRem indicates an expected call of SRem
+func (mr *MockContextClientMockRecorder) SRem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockContextClient)(nil).SRem), varargs...)
+}
+
+// SScan mocks base method
+func (m *MockContextClient) SScan(arg0 context.Context, arg1 string, arg2 uint64, arg3 string, arg4 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SScan", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// SScan indicates an expected call of SScan
+func (mr *MockContextClientMockRecorder) SScan(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SScan", reflect.TypeOf((*MockContextClient)(nil).SScan), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Scan mocks base method
+func (m *MockContextClient) Scan(arg0 context.Context, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan
+func (mr *MockContextClientMockRecorder) Scan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockContextClient)(nil).Scan), arg0, arg1, arg2, arg3)
+}
+
+// ScriptExists mocks base method
+func (m *MockContextClient) ScriptExists(arg0 context.Context, arg1 ...string) *redis.BoolSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScriptExists", varargs...)
+	ret0, _ := ret[0].(*redis.BoolSliceCmd)
+	return ret0
+}
+
+// ScriptExists indicates an expected call of ScriptExists
+func (mr *MockContextClientMockRecorder) ScriptExists(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptExists", reflect.TypeOf((*MockContextClient)(nil).ScriptExists), varargs...)
+}
+
+// ScriptLoad mocks base method
+func (m *MockContextClient) ScriptLoad(arg0 context.Context, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptLoad", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// ScriptLoad indicates an expected call of ScriptLoad
+func (mr *MockContextClientMockRecorder) ScriptLoad(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptLoad", reflect.TypeOf((*MockContextClient)(nil).ScriptLoad), arg0, arg1)
+}
+
+// Set mocks base method
+func (m *MockContextClient) Set(arg0 context.Context, arg1 string, arg2 interface{}, arg3 time.Duration) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Set indicates an expected call of Set
+func (mr *MockContextClientMockRecorder) Set(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockContextClient)(nil).Set), arg0, arg1, arg2, arg3)
+}
+
+// SetBit mocks base method
+func (m *MockContextClient) SetBit(arg0 context.Context, arg1 string, arg2 int64, arg3 int) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetBit indicates an expected call of SetBit
+func (mr *MockContextClientMockRecorder) SetBit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBit", reflect.TypeOf((*MockContextClient)(nil).SetBit), arg0, arg1, arg2, arg3)
+}
+
+// SetNX mocks base method
+func (m *MockContextClient) SetNX(arg0 context.Context, arg1 string, arg2 interface{}, arg3 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SetNX indicates an expected call of SetNX
+func (mr *MockContextClientMockRecorder) SetNX(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockContextClient)(nil).SetNX), arg0, arg1, arg2, arg3)
+}
+
+// SetRange mocks base method
+func (m *MockContextClient) SetRange(arg0 context.Context, arg1 string, arg2 int64, arg3 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetRange indicates an expected call of SetRange
+func (mr *MockContextClientMockRecorder) SetRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRange", reflect.TypeOf((*MockContextClient)(nil).SetRange), arg0, arg1, arg2, arg3)
+}
+
+// StrLen mocks base method
+func (m *MockContextClient) StrLen(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrLen", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// StrLen indicates an expected call of StrLen
+func (mr *MockContextClientMockRecorder) StrLen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrLen", reflect.TypeOf((*MockContextClient)(nil).StrLen), arg0, arg1)
+}
+
+// Subscribe mocks base method
+func (m *MockContextClient) Subscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Subscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockContextClientMockRecorder) Subscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockContextClient)(nil).Subscribe), varargs...)
+}
+
+// TTL mocks base method
+func (m *MockContextClient) TTL(arg0 context.Context, arg1 string) *redis.DurationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL", arg0, arg1)
+	ret0, _ := ret[0].(*redis.DurationCmd)
+	return ret0
+}
+
+// TTL indicates an expected call of TTL
+func (mr *MockContextClientMockRecorder) TTL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockContextClient)(nil).TTL), arg0, arg1)
+}
+
+// TxPipelined mocks base method
+func (m *MockContextClient) TxPipelined(arg0 context.Context, arg1 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipelined", arg0, arg1)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxPipelined indicates an expected call of TxPipelined
+func (mr *MockContextClientMockRecorder) TxPipelined(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipelined", reflect.TypeOf((*MockContextClient)(nil).TxPipelined), arg0, arg1)
+}
+
+// Type mocks base method
+func (m *MockContextClient) Type(arg0 context.Context, arg1 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Type indicates an expected call of Type
+func (mr *MockContextClientMockRecorder) Type(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockContextClient)(nil).Type), arg0, arg1)
+}
+
+// Unlink mocks base method
+func (m *MockContextClient) Unlink(arg0 context.Context, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlink", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink
+func (mr *MockContextClientMockRecorder) Unlink(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockContextClient)(nil).Unlink), varargs...)
+}
+
+// Watch mocks base method
+func (m *MockContextClient) Watch(arg0 context.Context, arg1 func(*redis.Tx) error, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockContextClientMockRecorder) Watch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockContextClient)(nil).Watch), varargs...)
+}
+
+// XAck mocks base method
+func (m *MockContextClient) XAck(arg0 context.Context, arg1, arg2 string, arg3 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XAck", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XAck indicates an expected call of XAck
+func (mr *MockContextClientMockRecorder) XAck(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAck", reflect.TypeOf((*MockContextClient)(nil).XAck), varargs...)
+}
+
+// XAdd mocks base method
+func (m *MockContextClient) XAdd(arg0 context.Context, arg1 *redis.XAddArgs) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XAdd", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// XAdd indicates an expected call of XAdd
+func (mr *MockContextClientMockRecorder) XAdd(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAdd", reflect.TypeOf((*MockContextClient)(nil).XAdd), arg0, arg1)
+}
+
+// XClaim mocks base method
+func (m *MockContextClient) XClaim(arg0 context.Context, arg1 *redis.XClaimArgs) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaim", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XClaim indicates an expected call of XClaim
+func (mr *MockContextClientMockRecorder) XClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaim", reflect.TypeOf((*MockContextClient)(nil).XClaim), arg0, arg1)
+}
+
+// XClaimJustID mocks base method
+func (m *MockContextClient) XClaimJustID(arg0 context.Context, arg1 *redis.XClaimArgs) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaimJustID", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// XClaimJustID indicates an expected call of XClaimJustID
+func (mr *MockContextClientMockRecorder) XClaimJustID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaimJustID", reflect.TypeOf((*MockContextClient)(nil).XClaimJustID), arg0, arg1)
+}
+
+// XDel mocks base method
+func (m *MockContextClient) XDel(arg0 context.Context, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XDel", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XDel indicates an expected call of XDel
+func (mr *MockContextClientMockRecorder) XDel(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XDel", reflect.TypeOf((*MockContextClient)(nil).XDel), varargs...)
+}
+
+// XGroupCreate mocks base method
+func (m *MockContextClient) XGroupCreate(arg0 context.Context, arg1, arg2, arg3 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreate indicates an expected call of XGroupCreate
+func (mr *MockContextClientMockRecorder) XGroupCreate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreate", reflect.TypeOf((*MockContextClient)(nil).XGroupCreate), arg0, arg1, arg2, arg3)
+}
+
+// XGroupCreateMkStream mocks base method
+func (m *MockContextClient) XGroupCreateMkStream(arg0 context.Context, arg1, arg2, arg3 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreateMkStream", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreateMkStream indicates an expected call of XGroupCreateMkStream
+func (mr *MockContextClientMockRecorder) XGroupCreateMkStream(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreateMkStream", reflect.TypeOf((*MockContextClient)(nil).XGroupCreateMkStream), arg0, arg1, arg2, arg3)
+}
+
+// XGroupDelConsumer mocks base method
+func (m *MockContextClient) XGroupDelConsumer(arg0 context.Context, arg1, arg2, arg3 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDelConsumer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDelConsumer indicates an expected call of XGroupDelConsumer
+func (mr *MockContextClientMockRecorder) XGroupDelConsumer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDelConsumer", reflect.TypeOf((*MockContextClient)(nil).XGroupDelConsumer), arg0, arg1, arg2, arg3)
+}
+
+// XGroupDestroy mocks base method
+func (m *MockContextClient) XGroupDestroy(arg0 context.Context, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDestroy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDestroy indicates an expected call of XGroupDestroy
+func (mr *MockContextClientMockRecorder) XGroupDestroy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDestroy", reflect.TypeOf((*MockContextClient)(nil).XGroupDestroy), arg0, arg1, arg2)
+}
+
+// XGroupSetID mocks base method
+func (m *MockContextClient) XGroupSetID(arg0 context.Context, arg1, arg2, arg3 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupSetID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupSetID indicates an expected call of XGroupSetID
+func (mr *MockContextClientMockRecorder) XGroupSetID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupSetID", reflect.TypeOf((*MockContextClient)(nil).XGroupSetID), arg0, arg1, arg2, arg3)
+}
+
+// XLen mocks base method
+func (m *MockContextClient) XLen(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XLen", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XLen indicates an expected call of XLen
+func (mr *MockContextClientMockRecorder) XLen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XLen", reflect.TypeOf((*MockContextClient)(nil).XLen), arg0, arg1)
+}
+
+// XPending mocks base method
+func (m *MockContextClient) XPending(arg0 context.Context, arg1, arg2 string) *redis.XPendingCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.XPendingCmd)
+	return ret0
+}
+
+// XPending indicates an expected call of XPending
+func (mr *MockContextClientMockRecorder) XPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPending", reflect.TypeOf((*MockContextClient)(nil).XPending), arg0, arg1, arg2)
+}
+
+// XPendingExt mocks base method
+func (m *MockContextClient) XPendingExt(arg0 context.Context, arg1 *redis.XPendingExtArgs) *redis.XPendingExtCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPendingExt", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XPendingExtCmd)
+	return ret0
+}
+
+// XPendingExt indicates an expected call of XPendingExt
+func (mr *MockContextClientMockRecorder) XPendingExt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPendingExt", reflect.TypeOf((*MockContextClient)(nil).XPendingExt), arg0, arg1)
+}
+
+// XRange mocks base method
+func (m *MockContextClient) XRange(arg0 context.Context, arg1, arg2, arg3 string) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XRange indicates an expected call of XRange
+func (mr *MockContextClientMockRecorder) XRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRange", reflect.TypeOf((*MockContextClient)(nil).XRange), arg0, arg1, arg2, arg3)
+}
+
+// XRead mocks base method
+func (m *MockContextClient) XRead(arg0 context.Context, arg1 *redis.XReadArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRead", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XRead indicates an expected call of XRead
+func (mr *MockContextClientMockRecorder) XRead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRead", reflect.TypeOf((*MockContextClient)(nil).XRead), arg0, arg1)
+}
+
+// XReadGroup mocks base method
+func (m *MockContextClient) XReadGroup(arg0 context.Context, arg1 *redis.XReadGroupArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XReadGroup", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XReadGroup indicates an expected call of XReadGroup
+func (mr *MockContextClientMockRecorder) XReadGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XReadGroup", reflect.TypeOf((*MockContextClient)(nil).XReadGroup), arg0, arg1)
+}
+
+// XTrim mocks base method
+func (m *MockContextClient) XTrim(arg0 context.Context, arg1 string, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XTrim", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XTrim indicates an expected call of XTrim
+func (mr *MockContextClientMockRecorder) XTrim(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrim", reflect.TypeOf((*MockContextClient)(nil).XTrim), arg0, arg1, arg2)
+}
+
+// XTrimApprox mocks base method
+func (m *MockContextClient) XTrimApprox(arg0 context.Context, arg1 string, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XTrimApprox", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XTrimApprox indicates an expected call of XTrimApprox
+func (mr *MockContextClientMockRecorder) XTrimApprox(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrimApprox", reflect.TypeOf((*MockContextClient)(nil).XTrimApprox), arg0, arg1, arg2)
+}
+
+// ZAdd mocks base method
+func (m *MockContextClient) ZAdd(arg0 context.Context, arg1 string, arg2 ...redis.Z) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZAdd indicates an expected call of ZAdd
+func (mr *MockContextClientMockRecorder) ZAdd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZAdd", reflect.TypeOf((*MockContextClient)(nil).ZAdd), varargs...)
+}
+
+// ZCard mocks base method
+func (m *MockContextClient) ZCard(arg0 context.Context, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZCard", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZCard indicates an expected call of ZCard
+func (mr *MockContextClientMockRecorder) ZCard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCard", reflect.TypeOf((*MockContextClient)(nil).ZCard), arg0, arg1)
+}
+
+// ZCount mocks base method
+func (m *MockContextClient) ZCount(arg0 context.Context, arg1, arg2, arg3 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZCount", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZCount indicates an expected call of ZCount
+func (mr *MockContextClientMockRecorder) ZCount(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCount", reflect.TypeOf((*MockContextClient)(nil).ZCount), arg0, arg1, arg2, arg3)
+}
+
+// ZIncrBy mocks base method
+func (m *MockContextClient) ZIncrBy(arg0 context.Context, arg1 string, arg2 float64, arg3 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZIncrBy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// ZIncrBy indicates an expected call of ZIncrBy
+func (mr *MockContextClientMockRecorder) ZIncrBy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZIncrBy", reflect.TypeOf((*MockContextClient)(nil).ZIncrBy), arg0, arg1, arg2, arg3)
+}
+
+// ZInterStore mocks base method
+func (m *MockContextClient) ZInterStore(arg0 context.Context, arg1 string, arg2 redis.ZStore, arg3 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZInterStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZInterStore indicates an expected call of ZInterStore
+func (mr *MockContextClientMockRecorder) ZInterStore(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZInterStore", reflect.TypeOf((*MockContextClient)(nil).ZInterStore), varargs...)
+}
+
+// ZPopMax mocks base method
+func (m *MockContextClient) ZPopMax(arg0 context.Context, arg1 string, arg2 ...int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZPopMax indicates an expected call of ZPopMax
+func (mr *MockContextClientMockRecorder) ZPopMax(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMax", reflect.TypeOf((*MockContextClient)(nil).ZPopMax), varargs...)
+}
+
+// ZPopMin mocks base method
+func (m *MockContextClient) ZPopMin(arg0 context.Context, arg1 string, arg2 ...int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZPopMin indicates an expected call of ZPopMin
+func (mr *MockContextClientMockRecorder) ZPopMin(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMin", reflect.TypeOf((*MockContextClient)(nil).ZPopMin), varargs...)
+}
+
+// ZRange mocks base method
+func (m *MockContextClient) ZRange(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRange indicates an expected call of ZRange
+func (mr *MockContextClientMockRecorder) ZRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRange", reflect.TypeOf((*MockContextClient)(nil).ZRange), arg0, arg1, arg2, arg3)
+}
+
+// ZRangeByScore mocks base method
+func (m *MockContextClient) ZRangeByScore(arg0 context.Context, arg1 string, arg2 redis.ZRangeBy) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeByScore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRangeByScore indicates an expected call of ZRangeByScore
+func (mr *MockContextClientMockRecorder) ZRangeByScore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScore", reflect.TypeOf((*MockContextClient)(nil).ZRangeByScore), arg0, arg1, arg2)
+}
+
+// ZRangeByScoreWithScores mocks base method
+func (m *MockContextClient) ZRangeByScoreWithScores(arg0 context.Context, arg1 string, arg2 redis.ZRangeBy) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeByScoreWithScores", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRangeByScoreWithScores indicates an expected call of ZRangeByScoreWithScores
+func (mr *MockContextClientMockRecorder) ZRangeByScoreWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScoreWithScores", reflect.TypeOf((*MockContextClient)(nil).ZRangeByScoreWithScores), arg0, arg1, arg2)
+}
+
+// ZRangeWithScores mocks base method
+func (m *MockContextClient) ZRangeWithScores(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRangeWithScores", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRangeWithScores indicates an expected call of ZRangeWithScores
+func (mr *MockContextClientMockRecorder) ZRangeWithScores(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeWithScores", reflect.TypeOf((*MockContextClient)(nil).ZRangeWithScores), arg0, arg1, arg2, arg3)
+}
+
+// ZRank mocks base method
+func (m *MockContextClient) ZRank(arg0 context.Context, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRank", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRank indicates an expected call of ZRank
+func (mr *MockContextClientMockRecorder) ZRank(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRank", reflect.TypeOf((*MockContextClient)(nil).ZRank), arg0, arg1, arg2)
+}
+
+// ZRem mocks base method
+func (m *MockContextClient) ZRem(arg0 context.Context, arg1 string, arg2 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZRem", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRem indicates an expected call of ZRem
+func (mr *MockContextClientMockRecorder) ZRem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRem", reflect.TypeOf((*MockContextClient)(nil).ZRem), varargs...)
+}
+
+// ZRemRangeByRank mocks base method
+func (m *MockContextClient) ZRemRangeByRank(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByRank", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByRank indicates an expected call of ZRemRangeByRank
+func (mr *MockContextClientMockRecorder) ZRemRangeByRank(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByRank", reflect.TypeOf((*MockContextClient)(nil).ZRemRangeByRank), arg0, arg1, arg2, arg3)
+}
+
+// ZRemRangeByScore mocks base method
+func (m *MockContextClient) ZRemRangeByScore(arg0 context.Context, arg1, arg2, arg3 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByScore", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByScore indicates an expected call of ZRemRangeByScore
+func (mr *MockContextClientMockRecorder) ZRemRangeByScore(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByScore", reflect.TypeOf((*MockContextClient)(nil).ZRemRangeByScore), arg0, arg1, arg2, arg3)
+}
+
+// ZRevRangeByScore mocks base method
+func (m *MockContextClient) ZRevRangeByScore(arg0 context.Context, arg1 string, arg2 redis.ZRangeBy) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeByScore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// ZRevRangeByScore indicates an expected call of ZRevRangeByScore
+func (mr *MockContextClientMockRecorder) ZRevRangeByScore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScore", reflect.TypeOf((*MockContextClient)(nil).ZRevRangeByScore), arg0, arg1, arg2)
+}
+
+// ZRevRangeByScoreWithScores mocks base method
+func (m *MockContextClient) ZRevRangeByScoreWithScores(arg0 context.Context, arg1 string, arg2 redis.ZRangeBy) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeByScoreWithScores", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRevRangeByScoreWithScores indicates an expected call of ZRevRangeByScoreWithScores
+func (mr *MockContextClientMockRecorder) ZRevRangeByScoreWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScoreWithScores", reflect.TypeOf((*MockContextClient)(nil).ZRevRangeByScoreWithScores), arg0, arg1, arg2)
+}
+
+// ZRevRangeWithScores mocks base method
+func (m *MockContextClient) ZRevRangeWithScores(arg0 context.Context, arg1 string, arg2, arg3 int64) *redis.ZSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRangeWithScores", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
+	return ret0
+}
+
+// ZRevRangeWithScores indicates an expected call of ZRevRangeWithScores
+func (mr *MockContextClientMockRecorder) ZRevRangeWithScores(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeWithScores", reflect.TypeOf((*MockContextClient)(nil).ZRevRangeWithScores), arg0, arg1, arg2, arg3)
+}
+
+// ZRevRank mocks base method
+func (m *MockContextClient) ZRevRank(arg0 context.Context, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRevRank", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRevRank indicates an expected call of ZRevRank
+func (mr *MockContextClientMockRecorder) ZRevRank(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockContextClient)(nil).ZRevRank), arg0, arg1, arg2)
+}
+
+// ZScan mocks base method
+func (m *MockContextClient) ZScan(arg0 context.Context, arg1 string, arg2 uint64, arg3 string, arg4 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZScan", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// ZScan indicates an expected call of ZScan
+func (mr *MockContextClientMockRecorder) ZScan(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScan", reflect.TypeOf((*MockContextClient)(nil).ZScan), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ZScore mocks base method
+func (m *MockContextClient) ZScore(arg0 context.Context, arg1, arg2 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZScore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// ZScore indicates an expected call of ZScore
+func (mr *MockContextClientMockRecorder) ZScore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScore", reflect.TypeOf((*MockContextClient)(nil).ZScore), arg0, arg1, arg2)
+}
+
+// ZUnionStore mocks base method
+func (m *MockContextClient) ZUnionStore(arg0 context.Context, arg1 string, arg2 redis.ZStore, arg3 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZUnionStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZUnionStore indicates an expected call of ZUnionStore
+func (mr *MockContextClientMockRecorder) ZUnionStore(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZUnionStore", reflect.TypeOf((*MockContextClient)(nil).ZUnionStore), varargs...)
+}
+
+// MockContextLocker is a mock of ContextLocker interface
+type MockContextLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextLockerMockRecorder
+}
+
+// MockContextLockerMockRecorder is the mock recorder for MockContextLocker
+type MockContextLockerMockRecorder struct {
+	mock *MockContextLocker
+}
+
+// NewMockContextLocker creates a new mock instance
+func NewMockContextLocker(ctrl *gomock.Controller) *MockContextLocker {
+	mock := &MockContextLocker{ctrl: ctrl}
+	mock.recorder = &MockContextLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContextLocker) EXPECT() *MockContextLockerMockRecorder {
+	return m.recorder
+}
+
+// Obtain mocks base method
+func (m *MockContextLocker) Obtain(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Obtain", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(go_extensions_redis.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Obtain indicates an expected call of Obtain
+func (mr *MockContextLockerMockRecorder) Obtain(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Obtain", reflect.TypeOf((*MockContextLocker)(nil).Obtain), arg0, arg1, arg2, arg3)
+}
+
+// MockContextMux is a mock of ContextMux interface
+type MockContextMux struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextMuxMockRecorder
+}
+
+// MockContextMuxMockRecorder is the mock recorder for MockContextMux
+type MockContextMuxMockRecorder struct {
+	mock *MockContextMux
+}
+
+// NewMockContextMux creates a new mock instance
+func NewMockContextMux(ctrl *gomock.Controller) *MockContextMux {
+	mock := &MockContextMux{ctrl: ctrl}
+	mock.recorder = &MockContextMuxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContextMux) EXPECT() *MockContextMuxMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method
+func (m *MockContextMux) All(arg0 context.Context) []go_extensions_redis.ContextClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", arg0)
+	ret0, _ := ret[0].([]go_extensions_redis.ContextClient)
+	return ret0
+}
+
+// All indicates an expected call of All
+func (mr *MockContextMuxMockRecorder) All(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockContextMux)(nil).All), arg0)
+}
+
+// Invalidate mocks base method
+func (m *MockContextMux) Invalidate(arg0 context.Context, arg1 go_extensions_redis.Hash) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invalidate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invalidate indicates an expected call of Invalidate
+func (mr *MockContextMuxMockRecorder) Invalidate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockContextMux)(nil).Invalidate), arg0, arg1)
+}
+
+// InvalidateMany mocks base method
+func (m *MockContextMux) InvalidateMany(arg0 context.Context, arg1 ...go_extensions_redis.Hash) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateMany", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateMany indicates an expected call of InvalidateMany
+func (mr *MockContextMuxMockRecorder) InvalidateMany(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateMany", reflect.TypeOf((*MockContextMux)(nil).InvalidateMany), varargs...)
+}
+
+// Mux mocks base method
+func (m *MockContextMux) Mux() go_extensions_redis.Mux {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mux")
+	ret0, _ := ret[0].(go_extensions_redis.Mux)
+	return ret0
+}
+
+// Mux indicates an expected call of Mux
+func (mr *MockContextMuxMockRecorder) Mux() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mux", reflect.TypeOf((*MockContextMux)(nil).Mux))
+}
+
+// Obtain mocks base method
+func (m *MockContextMux) Obtain(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Obtain", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(go_extensions_redis.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Obtain indicates an expected call of Obtain
+func (mr *MockContextMuxMockRecorder) Obtain(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Obtain", reflect.TypeOf((*MockContextMux)(nil).Obtain), arg0, arg1, arg2, arg3)
+}
+
+// On mocks base method
+func (m *MockContextMux) On(arg0 context.Context, arg1 go_extensions_redis.Hash) go_extensions_redis.ContextClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "On", arg0, arg1)
+	ret0, _ := ret[0].(go_extensions_redis.ContextClient)
+	return ret0
+}
+
+// On indicates an expected call of On
+func (mr *MockContextMuxMockRecorder) On(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "On", reflect.TypeOf((*MockContextMux)(nil).On), arg0, arg1)
+}
+
+// OnMany mocks base method
+func (m *MockContextMux) OnMany(arg0 context.Context, arg1 go_extensions_redis.Hash, arg2 ...go_extensions_redis.Hash) go_extensions_redis.ContextClient {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OnMany", varargs...)
+	ret0, _ := ret[0].(go_extensions_redis.ContextClient)
+	return ret0
+}
+
+// OnMany indicates an expected call of OnMany
+func (mr *MockContextMuxMockRecorder) OnMany(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMany", reflect.TypeOf((*MockContextMux)(nil).OnMany), varargs...)
+}
+
+// PSubscribe mocks base method
+func (m *MockContextMux) PSubscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PSubscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PSubscribe indicates an expected call of PSubscribe
+func (mr *MockContextMuxMockRecorder) PSubscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PSubscribe", reflect.TypeOf((*MockContextMux)(nil).PSubscribe), varargs...)
+}
+
+// Publish mocks base method
+func (m *MockContextMux) Publish(arg0 context.Context, arg1 go_extensions_redis.Hash, arg2 string, arg3 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockContextMuxMockRecorder) Publish(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockContextMux)(nil).Publish), arg0, arg1, arg2, arg3)
+}
+
+// Subscribe mocks base method
+func (m *MockContextMux) Subscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Subscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockContextMuxMockRecorder) Subscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockContextMux)(nil).Subscribe), varargs...)
+}
+
+// WithLockOn mocks base method
+func (m *MockContextMux) WithLockOn(arg0 context.Context, arg1 go_extensions_redis.Hash, arg2 func()) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithLockOn", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithLockOn indicates an expected call of WithLockOn
+func (mr *MockContextMuxMockRecorder) WithLockOn(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithLockOn", reflect.TypeOf((*MockContextMux)(nil).WithLockOn), arg0, arg1, arg2)
+}
+
+// MockContextObtainer is a mock of ContextObtainer interface
+type MockContextObtainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextObtainerMockRecorder
+}
+
+// MockContextObtainerMockRecorder is the mock recorder for MockContextObtainer
+type MockContextObtainerMockRecorder struct {
+	mock *MockContextObtainer
+}
+
+// NewMockContextObtainer creates a new mock instance
+func NewMockContextObtainer(ctrl *gomock.Controller) *MockContextObtainer {
+	mock := &MockContextObtainer{ctrl: ctrl}
+	mock.recorder = &MockContextObtainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContextObtainer) EXPECT() *MockContextObtainerMockRecorder {
+	return m.recorder
+}
+
+// ObtainContext mocks base method
+func (m *MockContextObtainer) ObtainContext(arg0 context.Context, arg1 string, arg2 time.Duration, arg3 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObtainContext", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(go_extensions_redis.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObtainContext indicates an expected call of ObtainContext
+func (mr *MockContextObtainerMockRecorder) ObtainContext(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObtainContext", reflect.TypeOf((*MockContextObtainer)(nil).ObtainContext), arg0, arg1, arg2, arg3)
+}
+
+// MockLock is a mock of Lock interface
+type MockLock struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockMockRecorder
+}
+
+// MockLockMockRecorder is the mock recorder for MockLock
+type MockLockMockRecorder struct {
+	mock *MockLock
+}
+
+// NewMockLock creates a new mock instance
+func NewMockLock(ctrl *gomock.Controller) *MockLock {
+	mock := &MockLock{ctrl: ctrl}
+	mock.recorder = &MockLockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLock) EXPECT() *MockLockMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method
+func (m *MockLock) Release() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release
+func (mr *MockLockMockRecorder) Release() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockLock)(nil).Release))
+}
+
+// MockLocker is a mock of Locker interface
+type MockLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockerMockRecorder
+}
+
+// MockLockerMockRecorder is the mock recorder for MockLocker
+type MockLockerMockRecorder struct {
+	mock *MockLocker
+}
+
+// NewMockLocker creates a new mock instance
+func NewMockLocker(ctrl *gomock.Controller) *MockLocker {
+	mock := &MockLocker{ctrl: ctrl}
+	mock.recorder = &MockLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLocker) EXPECT() *MockLockerMockRecorder {
+	return m.recorder
+}
+
+// Obtain mocks base method
+func (m *MockLocker) Obtain(arg0 string, arg1 time.Duration, arg2 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Obtain", arg0, arg1, arg2)
+	ret0, _ := ret[0].(go_extensions_redis.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Obtain indicates an expected call of Obtain
+func (mr *MockLockerMockRecorder) Obtain(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Obtain", reflect.TypeOf((*MockLocker)(nil).Obtain), arg0, arg1, arg2)
+}
+
+// MockLockerClient is a mock of LockerClient interface
+type MockLockerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLockerClientMockRecorder
+}
+
+// MockLockerClientMockRecorder is the mock recorder for MockLockerClient
+type MockLockerClientMockRecorder struct {
+	mock *MockLockerClient
+}
+
+// NewMockLockerClient creates a new mock instance
+func NewMockLockerClient(ctrl *gomock.Controller) *MockLockerClient {
+	mock := &MockLockerClient{ctrl: ctrl}
+	mock.recorder = &MockLockerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLockerClient) EXPECT() *MockLockerClientMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method
+func (m *MockLockerClient) Append(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Append indicates an expected call of Append
+func (mr *MockLockerClientMockRecorder) Append(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLockerClient)(nil).Append), arg0, arg1)
+}
+
+// BLPop mocks base method
+func (m *MockLockerClient) BLPop(arg0 time.Duration, arg1 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BLPop", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// BLPop indicates an expected call of BLPop
+func (mr *MockLockerClientMockRecorder) BLPop(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BLPop", reflect.TypeOf((*MockLockerClient)(nil).BLPop), varargs...)
+}
+
+// BRPop mocks base method
+func (m *MockLockerClient) BRPop(arg0 time.Duration, arg1 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BRPop", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// BRPop indicates an expected call of BRPop
+func (mr *MockLockerClientMockRecorder) BRPop(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPop", reflect.TypeOf((*MockLockerClient)(nil).BRPop), varargs...)
+}
+
+// BRPopLPush mocks base method
+func (m *MockLockerClient) BRPopLPush(arg0, arg1 string, arg2 time.Duration) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BRPopLPush", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// BRPopLPush indicates an expected call of BRPopLPush
+func (mr *MockLockerClientMockRecorder) BRPopLPush(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BRPopLPush", reflect.TypeOf((*MockLockerClient)(nil).BRPopLPush), arg0, arg1, arg2)
+}
+
+// BZPopMax mocks base method
+func (m *MockLockerClient) BZPopMax(arg0 time.Duration, arg1 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMax indicates an expected call of BZPopMax
+func (mr *MockLockerClientMockRecorder) BZPopMax(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMax", reflect.TypeOf((*MockLockerClient)(nil).BZPopMax), varargs...)
+}
+
+// BZPopMin mocks base method
+func (m *MockLockerClient) BZPopMin(arg0 time.Duration, arg1 ...string) *redis.ZWithKeyCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZWithKeyCmd)
+	return ret0
+}
+
+// BZPopMin indicates an expected call of BZPopMin
+func (mr *MockLockerClientMockRecorder) BZPopMin(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BZPopMin", reflect.TypeOf((*MockLockerClient)(nil).BZPopMin), varargs...)
+}
+
+// BitCount mocks base method
+func (m *MockLockerClient) BitCount(arg0 string, arg1 *redis.BitCount) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BitCount", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitCount indicates an expected call of BitCount
+func (mr *MockLockerClientMockRecorder) BitCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitCount", reflect.TypeOf((*MockLockerClient)(nil).BitCount), arg0, arg1)
+}
+
+// BitField mocks base method
+func (m *MockLockerClient) BitField(arg0 string, arg1 ...interface{}) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitField", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// BitField indicates an expected call of BitField
+func (mr *MockLockerClientMockRecorder) BitField(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitField", reflect.TypeOf((*MockLockerClient)(nil).BitField), varargs...)
+}
+
+// BitOpAnd mocks base method
+func (m *MockLockerClient) BitOpAnd(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpAnd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpAnd indicates an expected call of BitOpAnd
+func (mr *MockLockerClientMockRecorder) BitOpAnd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpAnd", reflect.TypeOf((*MockLockerClient)(nil).BitOpAnd), varargs...)
+}
+
+// BitOpOr mocks base method
+func (m *MockLockerClient) BitOpOr(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitOpOr", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitOpOr indicates an expected call of BitOpOr
+func (mr *MockLockerClientMockRecorder) BitOpOr(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitOpOr", reflect.TypeOf((*MockLockerClient)(nil).BitOpOr), varargs...)
+}
+
+// BitPos mocks base method
+func (m *MockLockerClient) BitPos(arg0 string, arg1 int64, arg2 ...int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BitPos", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// BitPos indicates an expected call of BitPos
+func (mr *MockLockerClientMockRecorder) BitPos(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BitPos", reflect.TypeOf((*MockLockerClient)(nil).BitPos), varargs...)
+}
+
+// Close mocks base method
+func (m *MockLockerClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close
+func (mr *MockLockerClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockLockerClient)(nil).Close))
+}
+
+// Context mocks base method
+func (m *MockLockerClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockLockerClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLockerClient)(nil).Context))
+}
+
+// Decr mocks base method
+func (m *MockLockerClient) Decr(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decr", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Decr indicates an expected call of Decr
+func (mr *MockLockerClientMockRecorder) Decr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decr", reflect.TypeOf((*MockLockerClient)(nil).Decr), arg0)
+}
+
+// DecrBy mocks base method
+func (m *MockLockerClient) DecrBy(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrBy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// DecrBy indicates an expected call of DecrBy
+func (mr *MockLockerClientMockRecorder) DecrBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrBy", reflect.TypeOf((*MockLockerClient)(nil).DecrBy), arg0, arg1)
+}
+
+// Del mocks base method
+func (m *MockLockerClient) Del(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Del", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Del indicates an expected call of Del
+func (mr *MockLockerClientMockRecorder) Del(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockLockerClient)(nil).Del), arg0...)
+}
+
+// Eval mocks base method
+func (m *MockLockerClient) Eval(arg0 string, arg1 []string, arg2 ...interface{}) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eval", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// Eval indicates an expected call of Eval
+func (mr *MockLockerClientMockRecorder) Eval(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockLockerClient)(nil).Eval), varargs...)
+}
+
+// EvalSha mocks base method
+func (m *MockLockerClient) EvalSha(arg0 string, arg1 []string, arg2 ...interface{}) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvalSha", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// EvalSha indicates an expected call of EvalSha
+func (mr *MockLockerClientMockRecorder) EvalSha(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvalSha", reflect.TypeOf((*MockLockerClient)(nil).EvalSha), varargs...)
+}
+
+// Exists mocks base method
+func (m *MockLockerClient) Exists(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exists", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockLockerClientMockRecorder) Exists(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockLockerClient)(nil).Exists), arg0...)
+}
+
+// Expire mocks base method
+func (m *MockLockerClient) Expire(arg0 string, arg1 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire
+func (mr *MockLockerClientMockRecorder) Expire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockLockerClient)(nil).Expire), arg0, arg1)
+}
+
+// ExpireAt mocks base method
+func (m *MockLockerClient) ExpireAt(arg0 string, arg1 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAt", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// ExpireAt indicates an expected call of ExpireAt
+func (mr *MockLockerClientMockRecorder) ExpireAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAt", reflect.TypeOf((*MockLockerClient)(nil).ExpireAt), arg0, arg1)
+}
+
+// GeoAdd mocks base method
+func (m *MockLockerClient) GeoAdd(arg0 string, arg1 ...*redis.GeoLocation) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GeoAdd indicates an expected call of GeoAdd
+func (mr *MockLockerClientMockRecorder) GeoAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoAdd", reflect.TypeOf((*MockLockerClient)(nil).GeoAdd), varargs...)
+}
+
+// GeoDist mocks base method
+func (m *MockLockerClient) GeoDist(arg0, arg1, arg2, arg3 string) *redis.FloatCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoDist", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.FloatCmd)
+	return ret0
+}
+
+// GeoDist indicates an expected call of GeoDist
+func (mr *MockLockerClientMockRecorder) GeoDist(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoDist", reflect.TypeOf((*MockLockerClient)(nil).GeoDist), arg0, arg1, arg2, arg3)
+}
+
+// GeoHash mocks base method
+func (m *MockLockerClient) GeoHash(arg0 string, arg1 ...string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoHash", varargs...)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// GeoHash indicates an expected call of GeoHash
+func (mr *MockLockerClientMockRecorder) GeoHash(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoHash", reflect.TypeOf((*MockLockerClient)(nil).GeoHash), varargs...)
+}
+
+// GeoPos mocks base method
+func (m *MockLockerClient) GeoPos(arg0 string, arg1 ...string) *redis.GeoPosCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GeoPos", varargs...)
+	ret0, _ := ret[0].(*redis.GeoPosCmd)
+	return ret0
+}
+
+// GeoPos indicates an expected call of GeoPos
+func (mr *MockLockerClientMockRecorder) GeoPos(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoPos", reflect.TypeOf((*MockLockerClient)(nil).GeoPos), varargs...)
+}
+
+// GeoRadius mocks base method
+func (m *MockLockerClient) GeoRadius(arg0 string, arg1, arg2 float64, arg3 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadius", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadius indicates an expected call of GeoRadius
+func (mr *MockLockerClientMockRecorder) GeoRadius(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadius", reflect.TypeOf((*MockLockerClient)(nil).GeoRadius), arg0, arg1, arg2, arg3)
+}
+
+// GeoRadiusByMember mocks base method
+func (m *MockLockerClient) GeoRadiusByMember(arg0, arg1 string, arg2 *redis.GeoRadiusQuery) *redis.GeoLocationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeoRadiusByMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.GeoLocationCmd)
+	return ret0
+}
+
+// GeoRadiusByMember indicates an expected call of GeoRadiusByMember
+func (mr *MockLockerClientMockRecorder) GeoRadiusByMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeoRadiusByMember", reflect.TypeOf((*MockLockerClient)(nil).GeoRadiusByMember), arg0, arg1, arg2)
+}
+
+// Get mocks base method
+func (m *MockLockerClient) Get(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockLockerClientMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLockerClient)(nil).Get), arg0)
+}
+
+// GetBit mocks base method
+func (m *MockLockerClient) GetBit(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBit", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// GetBit indicates an expected call of GetBit
+func (mr *MockLockerClientMockRecorder) GetBit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBit", reflect.TypeOf((*MockLockerClient)(nil).GetBit), arg0, arg1)
+}
+
+// GetRange mocks base method
+func (m *MockLockerClient) GetRange(arg0 string, arg1, arg2 int64) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetRange indicates an expected call of GetRange
+func (mr *MockLockerClientMockRecorder) GetRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRange", reflect.TypeOf((*MockLockerClient)(nil).GetRange), arg0, arg1, arg2)
+}
+
+// GetSet mocks base method
+func (m *MockLockerClient) GetSet(arg0 string, arg1 interface{}) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSet", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// GetSet indicates an expected call of GetSet
+func (mr *MockLockerClientMockRecorder) GetSet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSet", reflect.TypeOf((*MockLockerClient)(nil).GetSet), arg0, arg1)
+}
+
+// HDel mocks base method
+func (m *MockLockerClient) HDel(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HDel", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// HDel indicates an expected call of HDel
+func (mr *MockLockerClientMockRecorder) HDel(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HDel", reflect.TypeOf((*MockLockerClient)(nil).HDel), varargs...)
+}
+
+// HGet mocks base method
+func (m *MockLockerClient) HGet(arg0, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGet", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// HGet indicates an expected call of HGet
+func (mr *MockLockerClientMockRecorder) HGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGet", reflect.TypeOf((*MockLockerClient)(nil).HGet), arg0, arg1)
+}
+
+// HGetAll mocks base method
+func (m *MockLockerClient) HGetAll(arg0 string) *redis.StringStringMapCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HGetAll", arg0)
+	ret0, _ := ret[0].(*redis.StringStringMapCmd)
+	return ret0
+}
+
+// HGetAll indicates an expected call of HGetAll
+func (mr *MockLockerClientMockRecorder) HGetAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HGetAll", reflect.TypeOf((*MockLockerClient)(nil).HGetAll), arg0)
+}
+
+// HMGet mocks base method
+func (m *MockLockerClient) HMGet(arg0 string, arg1 ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HMGet", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// HMGet indicates an expected call of HMGet
+func (mr *MockLockerClientMockRecorder) HMGet(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMGet", reflect.TypeOf((*MockLockerClient)(nil).HMGet), varargs...)
+}
+
+// HMSet mocks base method
+func (m *MockLockerClient) HMSet(arg0 string, arg1 map[string]interface{}) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HMSet", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// HMSet indicates an expected call of HMSet
+func (mr *MockLockerClientMockRecorder) HMSet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMSet", reflect.TypeOf((*MockLockerClient)(nil).HMSet), arg0, arg1)
+}
+
+// HScan mocks base method
+func (m *MockLockerClient) HScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// HScan indicates an expected call of HScan
+func (mr *MockLockerClientMockRecorder) HScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HScan", reflect.TypeOf((*MockLockerClient)(nil).HScan), arg0, arg1, arg2, arg3)
+}
+
+// HSet mocks base method
+func (m *MockLockerClient) HSet(arg0, arg1 string, arg2 interface{}) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// HSet indicates an expected call of HSet
+func (mr *MockLockerClientMockRecorder) HSet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HSet", reflect.TypeOf((*MockLockerClient)(nil).HSet), arg0, arg1, arg2)
+}
+
+// Incr mocks base method
+func (m *MockLockerClient) Incr(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Incr", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Incr indicates an expected call of Incr
+func (mr *MockLockerClientMockRecorder) Incr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockLockerClient)(nil).Incr), arg0)
+}
+
+// IncrBy mocks base method
+func (m *MockLockerClient) IncrBy(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrBy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// IncrBy indicates an expected call of IncrBy
+func (mr *MockLockerClientMockRecorder) IncrBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*MockLockerClient)(nil).IncrBy), arg0, arg1)
+}
+
+// LIndex mocks base method
+func (m *MockLockerClient) LIndex(arg0 string, arg1 int64) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LIndex", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LIndex indicates an expected call of LIndex
+func (mr *MockLockerClientMockRecorder) LIndex(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LIndex", reflect.TypeOf((*MockLockerClient)(nil).LIndex), arg0, arg1)
+}
+
+// LLen mocks base method
+func (m *MockLockerClient) LLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LLen indicates an expected call of LLen
+func (mr *MockLockerClientMockRecorder) LLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LLen", reflect.TypeOf((*MockLockerClient)(nil).LLen), arg0)
+}
+
+// LPop mocks base method
+func (m *MockLockerClient) LPop(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LPop", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// LPop indicates an expected call of LPop
+func (mr *MockLockerClientMockRecorder) LPop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPop", reflect.TypeOf((*MockLockerClient)(nil).LPop), arg0)
+}
+
+// LPush mocks base method
+func (m *MockLockerClient) LPush(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LPush", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LPush indicates an expected call of LPush
+func (mr *MockLockerClientMockRecorder) LPush(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LPush", reflect.TypeOf((*MockLockerClient)(nil).LPush), varargs...)
+}
+
+// LRange mocks base method
+func (m *MockLockerClient) LRange(arg0 string, arg1, arg2 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// LRange indicates an expected call of LRange
+func (mr *MockLockerClientMockRecorder) LRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRange", reflect.TypeOf((*MockLockerClient)(nil).LRange), arg0, arg1, arg2)
+}
+
+// LRem mocks base method
+func (m *MockLockerClient) LRem(arg0 string, arg1 int64, arg2 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LRem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// LRem indicates an expected call of LRem
+func (mr *MockLockerClientMockRecorder) LRem(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LRem", reflect.TypeOf((*MockLockerClient)(nil).LRem), arg0, arg1, arg2)
+}
+
+// LTrim mocks base method
+func (m *MockLockerClient) LTrim(arg0 string, arg1, arg2 int64) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LTrim", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// LTrim indicates an expected call of LTrim
+func (mr *MockLockerClientMockRecorder) LTrim(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LTrim", reflect.TypeOf((*MockLockerClient)(nil).LTrim), arg0, arg1, arg2)
+}
+
+// MGet mocks base method
+func (m *MockLockerClient) MGet(arg0 ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MGet", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// MGet indicates an expected call of MGet
+func (mr *MockLockerClientMockRecorder) MGet(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockLockerClient)(nil).MGet), arg0...)
+}
+
+// MSet mocks base method
+func (m *MockLockerClient) MSet(arg0 ...interface{}) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MSet", varargs...)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// MSet indicates an expected call of MSet
+func (mr *MockLockerClientMockRecorder) MSet(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MSet", reflect.TypeOf((*MockLockerClient)(nil).MSet), arg0...)
+}
+
+// Obtain mocks base method
+func (m *MockLockerClient) Obtain(arg0 string, arg1 time.Duration, arg2 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Obtain", arg0, arg1, arg2)
+	ret0, _ := ret[0].(go_extensions_redis.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Obtain indicates an expected call of Obtain
+func (mr *MockLockerClientMockRecorder) Obtain(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Obtain", reflect.TypeOf((*MockLockerClient)(nil).Obtain), arg0, arg1, arg2)
+}
+
+// Options mocks base method
+func (m *MockLockerClient) Options() *redis.Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Options")
+	ret0, _ := ret[0].(*redis.Options)
+	return ret0
+}
+
+// Options indicates an expected call of Options
+func (mr *MockLockerClientMockRecorder) Options() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Options", reflect.TypeOf((*MockLockerClient)(nil).Options))
+}
+
+// PExpire mocks base method
+func (m *MockLockerClient) PExpire(arg0 string, arg1 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpire", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpire indicates an expected call of PExpire
+func (mr *MockLockerClientMockRecorder) PExpire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpire", reflect.TypeOf((*MockLockerClient)(nil).PExpire), arg0, arg1)
+}
+
+// PExpireAt mocks base method
+func (m *MockLockerClient) PExpireAt(arg0 string, arg1 time.Time) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PExpireAt", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// PExpireAt indicates an expected call of PExpireAt
+func (mr *MockLockerClientMockRecorder) PExpireAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PExpireAt", reflect.TypeOf((*MockLockerClient)(nil).PExpireAt), arg0, arg1)
+}
+
+// PFAdd mocks base method
+func (m *MockLockerClient) PFAdd(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFAdd indicates an expected call of PFAdd
+func (mr *MockLockerClientMockRecorder) PFAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFAdd", reflect.TypeOf((*MockLockerClient)(nil).PFAdd), varargs...)
+}
+
+// PFCount mocks base method
+func (m *MockLockerClient) PFCount(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFCount", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// PFCount indicates an expected call of PFCount
+func (mr *MockLockerClientMockRecorder) PFCount(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFCount", reflect.TypeOf((*MockLockerClient)(nil).PFCount), arg0...)
+}
+
+// PFMerge mocks base method
+func (m *MockLockerClient) PFMerge(arg0 string, arg1 ...string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PFMerge", varargs...)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// PFMerge indicates an expected call of PFMerge
+func (mr *MockLockerClientMockRecorder) PFMerge(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PFMerge", reflect.TypeOf((*MockLockerClient)(nil).PFMerge), varargs...)
+}
+
+// PSubscribeContext mocks base method
+func (m *MockLockerClient) PSubscribeContext(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PSubscribeContext", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PSubscribeContext indicates an expected call of PSubscribeContext
+func (mr *MockLockerClientMockRecorder) PSubscribeContext(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PSubscribeContext", reflect.TypeOf((*MockLockerClient)(nil).PSubscribeContext), varargs...)
+}
+
+// PTTL mocks base method
+func (m *MockLockerClient) PTTL(arg0 string) *redis.DurationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PTTL", arg0)
+	ret0, _ := ret[0].(*redis.DurationCmd)
+	return ret0
+}
+
+// PTTL indicates an expected call of PTTL
+func (mr *MockLockerClientMockRecorder) PTTL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PTTL", reflect.TypeOf((*MockLockerClient)(nil).PTTL), arg0)
+}
+
+// Persist mocks base method
+func (m *MockLockerClient) Persist(arg0 string) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Persist", arg0)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Persist indicates an expected call of Persist
+func (mr *MockLockerClientMockRecorder) Persist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Persist", reflect.TypeOf((*MockLockerClient)(nil).Persist), arg0)
+}
+
+// Ping mocks base method
+func (m *MockLockerClient) Ping() *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping")
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping
+func (mr *MockLockerClientMockRecorder) Ping() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockLockerClient)(nil).Ping))
+}
+
+// Pipeline mocks base method
+func (m *MockLockerClient) Pipeline() redis.Pipeliner {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipeline")
+	ret0, _ := ret[0].(redis.Pipeliner)
+	return ret0
+}
+
+// Pipeline indicates an expected call of Pipeline
+func (mr *MockLockerClientMockRecorder) Pipeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipeline", reflect.TypeOf((*MockLockerClient)(nil).Pipeline))
+}
+
+// Pipelined mocks base method
+func (m *MockLockerClient) Pipelined(arg0 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipelined", arg0)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pipelined indicates an expected call of Pipelined
+func (mr *MockLockerClientMockRecorder) Pipelined(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipelined", reflect.TypeOf((*MockLockerClient)(nil).Pipelined), arg0)
+}
+
+// Process mocks base method
+func (m *MockLockerClient) Process(arg0 redis.Cmder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Process indicates an expected call of Process
+func (mr *MockLockerClientMockRecorder) Process(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockLockerClient)(nil).Process), arg0)
+}
+
+// Publish mocks base method
+func (m *MockLockerClient) Publish(arg0 string, arg1 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockLockerClientMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockLockerClient)(nil).Publish), arg0, arg1)
+}
+
+// RPop mocks base method
+func (m *MockLockerClient) RPop(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RPop", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// RPop indicates an expected call of RPop
+func (mr *MockLockerClientMockRecorder) RPop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPop", reflect.TypeOf((*MockLockerClient)(nil).RPop), arg0)
+}
+
+// RPopLPush mocks base method
+func (m *MockLockerClient) RPopLPush(arg0, arg1 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RPopLPush", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// RPopLPush indicates an expected call of RPopLPush
+func (mr *MockLockerClientMockRecorder) RPopLPush(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPopLPush", reflect.TypeOf((*MockLockerClient)(nil).RPopLPush), arg0, arg1)
+}
+
+// RPush mocks base method
+func (m *MockLockerClient) RPush(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RPush", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// RPush indicates an expected call of RPush
+func (mr *MockLockerClientMockRecorder) RPush(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RPush", reflect.TypeOf((*MockLockerClient)(nil).RPush), varargs...)
+}
+
+// Rename mocks base method
+func (m *MockLockerClient) Rename(arg0, arg1 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Rename indicates an expected call of Rename
+func (mr *MockLockerClientMockRecorder) Rename(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockLockerClient)(nil).Rename), arg0, arg1)
+}
+
+// SAdd mocks base method
+func (m *MockLockerClient) SAdd(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SAdd indicates an expected call of SAdd
+func (mr *MockLockerClientMockRecorder) SAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SAdd", reflect.TypeOf((*MockLockerClient)(nil).SAdd), varargs...)
+}
+
+// SCard mocks base method
+func (m *MockLockerClient) SCard(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SCard", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SCard indicates an expected call of SCard
+func (mr *MockLockerClientMockRecorder) SCard(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SCard", reflect.TypeOf((*MockLockerClient)(nil).SCard), arg0)
+}
+
+// SIsMember mocks base method
+func (m *MockLockerClient) SIsMember(arg0 string, arg1 interface{}) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SIsMember", arg0, arg1)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SIsMember indicates an expected call of SIsMember
+func (mr *MockLockerClientMockRecorder) SIsMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SIsMember", reflect.TypeOf((*MockLockerClient)(nil).SIsMember), arg0, arg1)
+}
+
+// SMembers mocks base method
+func (m *MockLockerClient) SMembers(arg0 string) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SMembers", arg0)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// SMembers indicates an expected call of SMembers
+func (mr *MockLockerClientMockRecorder) SMembers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMembers", reflect.TypeOf((*MockLockerClient)(nil).SMembers), arg0)
+}
+
+// SPopN mocks base method
+func (m *MockLockerClient) SPopN(arg0 string, arg1 int64) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SPopN", arg0, arg1)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// SPopN indicates an expected call of SPopN
+func (mr *MockLockerClientMockRecorder) SPopN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SPopN", reflect.TypeOf((*MockLockerClient)(nil).SPopN), arg0, arg1)
+}
+
+// SRem mocks base method
+func (m *MockLockerClient) SRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SRem", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SRem indicates an expected call of SRem
+func (mr *MockLockerClientMockRecorder) SRem(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SRem", reflect.TypeOf((*MockLockerClient)(nil).SRem), varargs...)
+}
+
+// SScan mocks base method
+func (m *MockLockerClient) SScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// SScan indicates an expected call of SScan
+func (mr *MockLockerClientMockRecorder) SScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SScan", reflect.TypeOf((*MockLockerClient)(nil).SScan), arg0, arg1, arg2, arg3)
+}
+
+// Scan mocks base method
+func (m *MockLockerClient) Scan(arg0 uint64, arg1 string, arg2 int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan
+func (mr *MockLockerClientMockRecorder) Scan(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockLockerClient)(nil).Scan), arg0, arg1, arg2)
+}
+
+// ScriptExists mocks base method
+func (m *MockLockerClient) ScriptExists(arg0 ...string) *redis.BoolSliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScriptExists", varargs...)
+	ret0, _ := ret[0].(*redis.BoolSliceCmd)
+	return ret0
+}
+
+// ScriptExists indicates an expected call of ScriptExists
+func (mr *MockLockerClientMockRecorder) ScriptExists(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptExists", reflect.TypeOf((*MockLockerClient)(nil).ScriptExists), arg0...)
+}
+
+// ScriptLoad mocks base method
+func (m *MockLockerClient) ScriptLoad(arg0 string) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptLoad", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// ScriptLoad indicates an expected call of ScriptLoad
+func (mr *MockLockerClientMockRecorder) ScriptLoad(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptLoad", reflect.TypeOf((*MockLockerClient)(nil).ScriptLoad), arg0)
+}
+
+// Set mocks base method
+func (m *MockLockerClient) Set(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Set indicates an expected call of Set
+func (mr *MockLockerClientMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLockerClient)(nil).Set), arg0, arg1, arg2)
+}
+
+// SetBit mocks base method
+func (m *MockLockerClient) SetBit(arg0 string, arg1 int64, arg2 int) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBit", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetBit indicates an expected call of SetBit
+func (mr *MockLockerClientMockRecorder) SetBit(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBit", reflect.TypeOf((*MockLockerClient)(nil).SetBit), arg0, arg1, arg2)
+}
+
+// SetNX mocks base method
+func (m *MockLockerClient) SetNX(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SetNX indicates an expected call of SetNX
+func (mr *MockLockerClientMockRecorder) SetNX(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockLockerClient)(nil).SetNX), arg0, arg1, arg2)
+}
+
+// SetRange mocks base method
+func (m *MockLockerClient) SetRange(arg0 string, arg1 int64, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// SetRange indicates an expected call of SetRange
+func (mr *MockLockerClientMockRecorder) SetRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRange", reflect.TypeOf((*MockLockerClient)(nil).SetRange), arg0, arg1, arg2)
+}
+
+// StrLen mocks base method
+func (m *MockLockerClient) StrLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StrLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// StrLen indicates an expected call of StrLen
+func (mr *MockLockerClientMockRecorder) StrLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StrLen", reflect.TypeOf((*MockLockerClient)(nil).StrLen), arg0)
+}
+
+// SubscribeContext mocks base method
+func (m *MockLockerClient) SubscribeContext(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeContext", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeContext indicates an expected call of SubscribeContext
+func (mr *MockLockerClientMockRecorder) SubscribeContext(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeContext", reflect.TypeOf((*MockLockerClient)(nil).SubscribeContext), varargs...)
+}
+
+// TTL mocks base method
+func (m *MockLockerClient) TTL(arg0 string) *redis.DurationCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TTL", arg0)
+	ret0, _ := ret[0].(*redis.DurationCmd)
+	return ret0
+}
+
+// TTL indicates an expected call of TTL
+func (mr *MockLockerClientMockRecorder) TTL(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockLockerClient)(nil).TTL), arg0)
+}
+
+// TxPipeline mocks base method
+func (m *MockLockerClient) TxPipeline() redis.Pipeliner {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipeline")
+	ret0, _ := ret[0].(redis.Pipeliner)
+	return ret0
+}
+
+// TxPipeline indicates an expected call of TxPipeline
+func (mr *MockLockerClientMockRecorder) TxPipeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipeline", reflect.TypeOf((*MockLockerClient)(nil).TxPipeline))
+}
+
+// TxPipelined mocks base method
+func (m *MockLockerClient) TxPipelined(arg0 func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipelined", arg0)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxPipelined indicates an expected call of TxPipelined
+func (mr *MockLockerClientMockRecorder) TxPipelined(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipelined", reflect.TypeOf((*MockLockerClient)(nil).TxPipelined), arg0)
+}
+
+// Type mocks base method
+func (m *MockLockerClient) Type(arg0 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type", arg0)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// Type indicates an expected call of Type
+func (mr *MockLockerClientMockRecorder) Type(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockLockerClient)(nil).Type), arg0)
+}
+
+// Unlink mocks base method
+func (m *MockLockerClient) Unlink(arg0 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlink", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink
+func (mr *MockLockerClientMockRecorder) Unlink(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockLockerClient)(nil).Unlink), arg0...)
+}
+
+// Watch mocks base method
+func (m *MockLockerClient) Watch(arg0 func(*redis.Tx) error, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockLockerClientMockRecorder) Watch(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockLockerClient)(nil).Watch), varargs...)
+}
+
+// WithContext mocks base method
+func (m *MockLockerClient) WithContext(arg0 context.Context) go_extensions_redis.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", arg0)
+	ret0, _ := ret[0].(go_extensions_redis.Client)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext
+func (mr *MockLockerClientMockRecorder) WithContext(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockLockerClient)(nil).WithContext), arg0)
+}
+
+// XAck mocks base method
+func (m *MockLockerClient) XAck(arg0, arg1 string, arg2 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XAck", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XAck indicates an expected call of XAck
+func (mr *MockLockerClientMockRecorder) XAck(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAck", reflect.TypeOf((*MockLockerClient)(nil).XAck), varargs...)
+}
+
+// XAdd mocks base method
+func (m *MockLockerClient) XAdd(arg0 *redis.XAddArgs) *redis.StringCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XAdd", arg0)
+	ret0, _ := ret[0].(*redis.StringCmd)
+	return ret0
+}
+
+// XAdd indicates an expected call of XAdd
+func (mr *MockLockerClientMockRecorder) XAdd(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XAdd", reflect.TypeOf((*MockLockerClient)(nil).XAdd), arg0)
+}
+
+// XClaim mocks base method
+func (m *MockLockerClient) XClaim(arg0 *redis.XClaimArgs) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaim", arg0)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XClaim indicates an expected call of XClaim
+func (mr *MockLockerClientMockRecorder) XClaim(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaim", reflect.TypeOf((*MockLockerClient)(nil).XClaim), arg0)
+}
+
+// XClaimJustID mocks base method
+func (m *MockLockerClient) XClaimJustID(arg0 *redis.XClaimArgs) *redis.StringSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XClaimJustID", arg0)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
+	return ret0
+}
+
+// XClaimJustID indicates an expected call of XClaimJustID
+func (mr *MockLockerClientMockRecorder) XClaimJustID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XClaimJustID", reflect.TypeOf((*MockLockerClient)(nil).XClaimJustID), arg0)
+}
+
+// XDel mocks base method
+func (m *MockLockerClient) XDel(arg0 string, arg1 ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "XDel", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XDel indicates an expected call of XDel
+func (mr *MockLockerClientMockRecorder) XDel(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XDel", reflect.TypeOf((*MockLockerClient)(nil).XDel), varargs...)
+}
+
+// XGroupCreate mocks base method
+func (m *MockLockerClient) XGroupCreate(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreate indicates an expected call of XGroupCreate
+func (mr *MockLockerClientMockRecorder) XGroupCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreate", reflect.TypeOf((*MockLockerClient)(nil).XGroupCreate), arg0, arg1, arg2)
+}
+
+// XGroupCreateMkStream mocks base method
+func (m *MockLockerClient) XGroupCreateMkStream(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupCreateMkStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupCreateMkStream indicates an expected call of XGroupCreateMkStream
+func (mr *MockLockerClientMockRecorder) XGroupCreateMkStream(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupCreateMkStream", reflect.TypeOf((*MockLockerClient)(nil).XGroupCreateMkStream), arg0, arg1, arg2)
+}
+
+// XGroupDelConsumer mocks base method
+func (m *MockLockerClient) XGroupDelConsumer(arg0, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDelConsumer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDelConsumer indicates an expected call of XGroupDelConsumer
+func (mr *MockLockerClientMockRecorder) XGroupDelConsumer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDelConsumer", reflect.TypeOf((*MockLockerClient)(nil).XGroupDelConsumer), arg0, arg1, arg2)
+}
+
+// XGroupDestroy mocks base method
+func (m *MockLockerClient) XGroupDestroy(arg0, arg1 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupDestroy", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XGroupDestroy indicates an expected call of XGroupDestroy
+func (mr *MockLockerClientMockRecorder) XGroupDestroy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupDestroy", reflect.TypeOf((*MockLockerClient)(nil).XGroupDestroy), arg0, arg1)
+}
+
+// XGroupSetID mocks base method
+func (m *MockLockerClient) XGroupSetID(arg0, arg1, arg2 string) *redis.StatusCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XGroupSetID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StatusCmd)
+	return ret0
+}
+
+// XGroupSetID indicates an expected call of XGroupSetID
+func (mr *MockLockerClientMockRecorder) XGroupSetID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XGroupSetID", reflect.TypeOf((*MockLockerClient)(nil).XGroupSetID), arg0, arg1, arg2)
+}
+
+// XLen mocks base method
+func (m *MockLockerClient) XLen(arg0 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XLen", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XLen indicates an expected call of XLen
+func (mr *MockLockerClientMockRecorder) XLen(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XLen", reflect.TypeOf((*MockLockerClient)(nil).XLen), arg0)
+}
+
+// XPending mocks base method
+func (m *MockLockerClient) XPending(arg0, arg1 string) *redis.XPendingCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPending", arg0, arg1)
+	ret0, _ := ret[0].(*redis.XPendingCmd)
+	return ret0
+}
+
+// XPending indicates an expected call of XPending
+func (mr *MockLockerClientMockRecorder) XPending(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPending", reflect.TypeOf((*MockLockerClient)(nil).XPending), arg0, arg1)
+}
+
+// XPendingExt mocks base method
+func (m *MockLockerClient) XPendingExt(arg0 *redis.XPendingExtArgs) *redis.XPendingExtCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XPendingExt", arg0)
+	ret0, _ := ret[0].(*redis.XPendingExtCmd)
+	return ret0
+}
+
+// XPendingExt indicates an expected call of XPendingExt
+func (mr *MockLockerClientMockRecorder) XPendingExt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XPendingExt", reflect.TypeOf((*MockLockerClient)(nil).XPendingExt), arg0)
+}
+
+// XRange mocks base method
+func (m *MockLockerClient) XRange(arg0, arg1, arg2 string) *redis.XMessageSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.XMessageSliceCmd)
+	return ret0
+}
+
+// XRange indicates an expected call of XRange
+func (mr *MockLockerClientMockRecorder) XRange(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRange", reflect.TypeOf((*MockLockerClient)(nil).XRange), arg0, arg1, arg2)
+}
+
+// XRead mocks base method
+func (m *MockLockerClient) XRead(arg0 *redis.XReadArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XRead", arg0)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XRead indicates an expected call of XRead
+func (mr *MockLockerClientMockRecorder) XRead(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XRead", reflect.TypeOf((*MockLockerClient)(nil).XRead), arg0)
+}
+
+// XReadGroup mocks base method
+func (m *MockLockerClient) XReadGroup(arg0 *redis.XReadGroupArgs) *redis.XStreamSliceCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XReadGroup", arg0)
+	ret0, _ := ret[0].(*redis.XStreamSliceCmd)
+	return ret0
+}
+
+// XReadGroup indicates an expected call of XReadGroup
+func (mr *MockLockerClientMockRecorder) XReadGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XReadGroup", reflect.TypeOf((*MockLockerClient)(nil).XReadGroup), arg0)
+}
+
+// XTrim mocks base method
+func (m *MockLockerClient) XTrim(arg0 string, arg1 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "XTrim", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// XTrim indicates an expected call of XTrim
+func (mr *MockLockerClientMockRecorder) XTrim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrim", reflect.TypeOf((*MockLockerClient)(nil).XTrim), arg0, arg1)
 }
 
-// ScriptExists mocks base method
-func (m *MockClient) ScriptExists(arg0 ...string) *redis.BoolSliceCmd {
+// XTrimApprox mocks base method
+func (m *MockLockerClient) XTrimApprox(arg0 string, arg1 int64) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
-	for _, a := range arg0 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ScriptExists", varargs...)
-	ret0, _ := ret[0].(*redis.BoolSliceCmd)
+	ret := m.ctrl.Call(m, "XTrimApprox", arg0, arg1)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// ScriptExists indicates an expected call of ScriptExists
-func (mr *MockClientMockRecorder) ScriptExists(arg0 ...interface{}) *gomock.Call {
+// XTrimApprox indicates an expected call of XTrimApprox
+func (mr *MockLockerClientMockRecorder) XTrimApprox(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptExists", reflect.TypeOf((*MockClient)(nil).ScriptExists), arg0...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "XTrimApprox", reflect.TypeOf((*MockLockerClient)(nil).XTrimApprox), arg0, arg1)
 }
 
-// ScriptLoad mocks base method
-func (m *MockClient) ScriptLoad(arg0 string) *redis.StringCmd {
+// ZAdd mocks base method
+func (m *MockLockerClient) ZAdd(arg0 string, arg1 ...redis.Z) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScriptLoad", arg0)
-	ret0, _ := ret[0].(*redis.StringCmd)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZAdd", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// ScriptLoad indicates an expected call of ScriptLoad
-func (mr *MockClientMockRecorder) ScriptLoad(arg0 interface{}) *gomock.Call {
+// ZAdd indicates an expected call of ZAdd
+func (mr *MockLockerClientMockRecorder) ZAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptLoad", reflect.TypeOf((*MockClient)(nil).ScriptLoad), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZAdd", reflect.TypeOf((*MockLockerClient)(nil).ZAdd), varargs...)
 }
 
-// Set mocks base method
-func (m *MockClient) Set(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.StatusCmd {
+// ZCard mocks base method
+func (m *MockLockerClient) ZCard(arg0 string) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*redis.StatusCmd)
+	ret := m.ctrl.Call(m, "ZCard", arg0)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// Set indicates an expected call of Set
-func (mr *MockClientMockRecorder) Set(arg0, arg1, arg2 interface{}) *gomock.Call {
+// ZCard indicates an expected call of ZCard
+func (mr *MockLockerClientMockRecorder) ZCard(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockClient)(nil).Set), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCard", reflect.TypeOf((*MockLockerClient)(nil).ZCard), arg0)
 }
 
-// SetNX mocks base method
-func (m *MockClient) SetNX(arg0 string, arg1 interface{}, arg2 time.Duration) *redis.BoolCmd {
+// ZCount mocks base method
+func (m *MockLockerClient) ZCount(arg0, arg1, arg2 string) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetNX", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*redis.BoolCmd)
+	ret := m.ctrl.Call(m, "ZCount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// SetNX indicates an expected call of SetNX
-func (mr *MockClientMockRecorder) SetNX(arg0, arg1, arg2 interface{}) *gomock.Call {
+// ZCount indicates an expected call of ZCount
+func (mr *MockLockerClientMockRecorder) ZCount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockClient)(nil).SetNX), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCount", reflect.TypeOf((*MockLockerClient)(nil).ZCount), arg0, arg1, arg2)
 }
 
-// TTL mocks base method
-func (m *MockClient) TTL(arg0 string) *redis.DurationCmd {
+// ZIncrBy mocks base method
+func (m *MockLockerClient) ZIncrBy(arg0 string, arg1 float64, arg2 string) *redis.FloatCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TTL", arg0)
-	ret0, _ := ret[0].(*redis.DurationCmd)
+	ret := m.ctrl.Call(m, "ZIncrBy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.FloatCmd)
 	return ret0
 }
 
-// TTL indicates an expected call of TTL
-func (mr *MockClientMockRecorder) TTL(arg0 interface{}) *gomock.Call {
+// ZIncrBy indicates an expected call of ZIncrBy
+func (mr *MockLockerClientMockRecorder) ZIncrBy(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockClient)(nil).TTL), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZIncrBy", reflect.TypeOf((*MockLockerClient)(nil).ZIncrBy), arg0, arg1, arg2)
 }
 
-// TxPipeline mocks base method
-func (m *MockClient) TxPipeline() redis.Pipeliner {
+// ZInterStore mocks base method
+func (m *MockLockerClient) ZInterStore(arg0 string, arg1 redis.ZStore, arg2 ...string) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TxPipeline")
-	ret0, _ := ret[0].(redis.Pipeliner)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZInterStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
 	return ret0
 }
 
-// TxPipeline indicates an expected call of TxPipeline
-func (mr *MockClientMockRecorder) TxPipeline() *gomock.Call {
+// ZInterStore indicates an expected call of ZInterStore
+func (mr *MockLockerClientMockRecorder) ZInterStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipeline", reflect.TypeOf((*MockClient)(nil).TxPipeline))
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZInterStore", reflect.TypeOf((*MockLockerClient)(nil).ZInterStore), varargs...)
 }
 
-// WithContext mocks base method
-func (m *MockClient) WithContext(arg0 context.Context) go_extensions_redis.Client {
+// ZPopMax mocks base method
+func (m *MockLockerClient) ZPopMax(arg0 string, arg1 ...int64) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WithContext", arg0)
-	ret0, _ := ret[0].(go_extensions_redis.Client)
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZPopMax", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
 	return ret0
 }
 
-// WithContext indicates an expected call of WithContext
-func (mr *MockClientMockRecorder) WithContext(arg0 interface{}) *gomock.Call {
+// ZPopMax indicates an expected call of ZPopMax
+func (mr *MockLockerClientMockRecorder) ZPopMax(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockClient)(nil).WithContext), arg0)
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMax", reflect.TypeOf((*MockLockerClient)(nil).ZPopMax), varargs...)
 }
 
-// ZAdd mocks base method
-func (m *MockClient) ZAdd(arg0 string, arg1 ...redis.Z) *redis.IntCmd {
+// ZPopMin mocks base method
+func (m *MockLockerClient) ZPopMin(arg0 string, arg1 ...int64) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0}
 	for _, a := range arg1 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ZAdd", varargs...)
-	ret0, _ := ret[0].(*redis.IntCmd)
+	ret := m.ctrl.Call(m, "ZPopMin", varargs...)
+	ret0, _ := ret[0].(*redis.ZSliceCmd)
 	return ret0
 }
 
-// ZAdd indicates an expected call of ZAdd
-func (mr *MockClientMockRecorder) ZAdd(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// ZPopMin indicates an expected call of ZPopMin
+func (mr *MockLockerClientMockRecorder) ZPopMin(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZAdd", reflect.TypeOf((*MockClient)(nil).ZAdd), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZPopMin", reflect.TypeOf((*MockLockerClient)(nil).ZPopMin), varargs...)
 }
 
-// ZCard mocks base method
-func (m *MockClient) ZCard(arg0 string) *redis.IntCmd {
+// ZRange mocks base method
+func (m *MockLockerClient) ZRange(arg0 string, arg1, arg2 int64) *redis.StringSliceCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ZCard", arg0)
-	ret0, _ := ret[0].(*redis.IntCmd)
+	ret := m.ctrl.Call(m, "ZRange", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.StringSliceCmd)
 	return ret0
 }
 
-// ZCard indicates an expected call of ZCard
-func (mr *MockClientMockRecorder) ZCard(arg0 interface{}) *gomock.Call {
+// ZRange indicates an expected call of ZRange
+func (mr *MockLockerClientMockRecorder) ZRange(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZCard", reflect.TypeOf((*MockClient)(nil).ZCard), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRange", reflect.TypeOf((*MockLockerClient)(nil).ZRange), arg0, arg1, arg2)
 }
 
 // ZRangeByScore mocks base method
-func (m *MockClient) ZRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
+func (m *MockLockerClient) ZRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRangeByScore", arg0, arg1)
 	ret0, _ := ret[0].(*redis.StringSliceCmd)
@@ -642,13 +6210,13 @@ func (m *MockClient) ZRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.Stri
 }
 
 // ZRangeByScore indicates an expected call of ZRangeByScore
-func (mr *MockClientMockRecorder) ZRangeByScore(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRangeByScore(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScore", reflect.TypeOf((*MockClient)(nil).ZRangeByScore), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScore", reflect.TypeOf((*MockLockerClient)(nil).ZRangeByScore), arg0, arg1)
 }
 
 // ZRangeByScoreWithScores mocks base method
-func (m *MockClient) ZRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
+func (m *MockLockerClient) ZRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRangeByScoreWithScores", arg0, arg1)
 	ret0, _ := ret[0].(*redis.ZSliceCmd)
@@ -656,13 +6224,13 @@ func (m *MockClient) ZRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *
 }
 
 // ZRangeByScoreWithScores indicates an expected call of ZRangeByScoreWithScores
-func (mr *MockClientMockRecorder) ZRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScoreWithScores", reflect.TypeOf((*MockClient)(nil).ZRangeByScoreWithScores), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeByScoreWithScores", reflect.TypeOf((*MockLockerClient)(nil).ZRangeByScoreWithScores), arg0, arg1)
 }
 
 // ZRangeWithScores mocks base method
-func (m *MockClient) ZRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
+func (m *MockLockerClient) ZRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRangeWithScores", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*redis.ZSliceCmd)
@@ -670,13 +6238,13 @@ func (m *MockClient) ZRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSli
 }
 
 // ZRangeWithScores indicates an expected call of ZRangeWithScores
-func (mr *MockClientMockRecorder) ZRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeWithScores", reflect.TypeOf((*MockClient)(nil).ZRangeWithScores), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRangeWithScores", reflect.TypeOf((*MockLockerClient)(nil).ZRangeWithScores), arg0, arg1, arg2)
 }
 
 // ZRank mocks base method
-func (m *MockClient) ZRank(arg0, arg1 string) *redis.IntCmd {
+func (m *MockLockerClient) ZRank(arg0, arg1 string) *redis.IntCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRank", arg0, arg1)
 	ret0, _ := ret[0].(*redis.IntCmd)
@@ -684,13 +6252,13 @@ func (m *MockClient) ZRank(arg0, arg1 string) *redis.IntCmd {
 }
 
 // ZRank indicates an expected call of ZRank
-func (mr *MockClientMockRecorder) ZRank(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRank(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRank", reflect.TypeOf((*MockClient)(nil).ZRank), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRank", reflect.TypeOf((*MockLockerClient)(nil).ZRank), arg0, arg1)
 }
 
 // ZRem mocks base method
-func (m *MockClient) ZRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
+func (m *MockLockerClient) ZRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0}
 	for _, a := range arg1 {
@@ -702,14 +6270,42 @@ func (m *MockClient) ZRem(arg0 string, arg1 ...interface{}) *redis.IntCmd {
 }
 
 // ZRem indicates an expected call of ZRem
-func (mr *MockClientMockRecorder) ZRem(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRem(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRem", reflect.TypeOf((*MockClient)(nil).ZRem), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRem", reflect.TypeOf((*MockLockerClient)(nil).ZRem), varargs...)
+}
+
+// ZRemRangeByRank mocks base method
+func (m *MockLockerClient) ZRemRangeByRank(arg0 string, arg1, arg2 int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByRank", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByRank indicates an expected call of ZRemRangeByRank
+func (mr *MockLockerClientMockRecorder) ZRemRangeByRank(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByRank", reflect.TypeOf((*MockLockerClient)(nil).ZRemRangeByRank), arg0, arg1, arg2)
+}
+
+// ZRemRangeByScore mocks base method
+func (m *MockLockerClient) ZRemRangeByScore(arg0, arg1, arg2 string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZRemRangeByScore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// ZRemRangeByScore indicates an expected call of ZRemRangeByScore
+func (mr *MockLockerClientMockRecorder) ZRemRangeByScore(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRemRangeByScore", reflect.TypeOf((*MockLockerClient)(nil).ZRemRangeByScore), arg0, arg1, arg2)
 }
 
 // ZRevRangeByScore mocks base method
-func (m *MockClient) ZRevRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
+func (m *MockLockerClient) ZRevRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.StringSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRevRangeByScore", arg0, arg1)
 	ret0, _ := ret[0].(*redis.StringSliceCmd)
@@ -717,13 +6313,13 @@ func (m *MockClient) ZRevRangeByScore(arg0 string, arg1 redis.ZRangeBy) *redis.S
 }
 
 // ZRevRangeByScore indicates an expected call of ZRevRangeByScore
-func (mr *MockClientMockRecorder) ZRevRangeByScore(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRevRangeByScore(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScore", reflect.TypeOf((*MockClient)(nil).ZRevRangeByScore), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScore", reflect.TypeOf((*MockLockerClient)(nil).ZRevRangeByScore), arg0, arg1)
 }
 
 // ZRevRangeByScoreWithScores mocks base method
-func (m *MockClient) ZRevRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
+func (m *MockLockerClient) ZRevRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRevRangeByScoreWithScores", arg0, arg1)
 	ret0, _ := ret[0].(*redis.ZSliceCmd)
@@ -731,13 +6327,13 @@ func (m *MockClient) ZRevRangeByScoreWithScores(arg0 string, arg1 redis.ZRangeBy
 }
 
 // ZRevRangeByScoreWithScores indicates an expected call of ZRevRangeByScoreWithScores
-func (mr *MockClientMockRecorder) ZRevRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRevRangeByScoreWithScores(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScoreWithScores", reflect.TypeOf((*MockClient)(nil).ZRevRangeByScoreWithScores), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeByScoreWithScores", reflect.TypeOf((*MockLockerClient)(nil).ZRevRangeByScoreWithScores), arg0, arg1)
 }
 
 // ZRevRangeWithScores mocks base method
-func (m *MockClient) ZRevRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
+func (m *MockLockerClient) ZRevRangeWithScores(arg0 string, arg1, arg2 int64) *redis.ZSliceCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRevRangeWithScores", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*redis.ZSliceCmd)
@@ -745,13 +6341,13 @@ func (m *MockClient) ZRevRangeWithScores(arg0 string, arg1, arg2 int64) *redis.Z
 }
 
 // ZRevRangeWithScores indicates an expected call of ZRevRangeWithScores
-func (mr *MockClientMockRecorder) ZRevRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRevRangeWithScores(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeWithScores", reflect.TypeOf((*MockClient)(nil).ZRevRangeWithScores), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRangeWithScores", reflect.TypeOf((*MockLockerClient)(nil).ZRevRangeWithScores), arg0, arg1, arg2)
 }
 
 // ZRevRank mocks base method
-func (m *MockClient) ZRevRank(arg0, arg1 string) *redis.IntCmd {
+func (m *MockLockerClient) ZRevRank(arg0, arg1 string) *redis.IntCmd {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ZRevRank", arg0, arg1)
 	ret0, _ := ret[0].(*redis.IntCmd)
@@ -759,98 +6355,56 @@ func (m *MockClient) ZRevRank(arg0, arg1 string) *redis.IntCmd {
 }
 
 // ZRevRank indicates an expected call of ZRevRank
-func (mr *MockClientMockRecorder) ZRevRank(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLockerClientMockRecorder) ZRevRank(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockClient)(nil).ZRevRank), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZRevRank", reflect.TypeOf((*MockLockerClient)(nil).ZRevRank), arg0, arg1)
 }
 
-// ZScore mocks base method
-func (m *MockClient) ZScore(arg0, arg1 string) *redis.FloatCmd {
+// ZScan mocks base method
+func (m *MockLockerClient) ZScan(arg0 string, arg1 uint64, arg2 string, arg3 int64) *redis.ScanCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ZScore", arg0, arg1)
-	ret0, _ := ret[0].(*redis.FloatCmd)
+	ret := m.ctrl.Call(m, "ZScan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*redis.ScanCmd)
 	return ret0
 }
 
-// ZScore indicates an expected call of ZScore
-func (mr *MockClientMockRecorder) ZScore(arg0, arg1 interface{}) *gomock.Call {
+// ZScan indicates an expected call of ZScan
+func (mr *MockLockerClientMockRecorder) ZScan(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScore", reflect.TypeOf((*MockClient)(nil).ZScore), arg0, arg1)
-}
-
-// MockLock is a mock of Lock interface
-type MockLock struct {
-	ctrl     *gomock.Controller
-	recorder *MockLockMockRecorder
-}
-
-// MockLockMockRecorder is the mock recorder for MockLock
-type MockLockMockRecorder struct {
-	mock *MockLock
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScan", reflect.TypeOf((*MockLockerClient)(nil).ZScan), arg0, arg1, arg2, arg3)
 }
 
-// NewMockLock creates a new mock instance
-func NewMockLock(ctrl *gomock.Controller) *MockLock {
-	mock := &MockLock{ctrl: ctrl}
-	mock.recorder = &MockLockMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockLock) EXPECT() *MockLockMockRecorder {
-	return m.recorder
-}
-
-// Release mocks base method
-func (m *MockLock) Release() error {
+// ZScore mocks base method
+func (m *MockLockerClient) ZScore(arg0, arg1 string) *redis.FloatCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Release")
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "ZScore", arg0, arg1)
+	ret0, _ := ret[0].(*redis.FloatCmd)
 	return ret0
 }
 
-// Release indicates an expected call of Release
-func (mr *MockLockMockRecorder) Release() *gomock.Call {
+// ZScore indicates an expected call of ZScore
+func (mr *MockLockerClientMockRecorder) ZScore(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockLock)(nil).Release))
-}
-
-// MockLocker is a mock of Locker interface
-type MockLocker struct {
-	ctrl     *gomock.Controller
-	recorder *MockLockerMockRecorder
-}
-
-// MockLockerMockRecorder is the mock recorder for MockLocker
-type MockLockerMockRecorder struct {
-	mock *MockLocker
-}
-
-// NewMockLocker creates a new mock instance
-func NewMockLocker(ctrl *gomock.Controller) *MockLocker {
-	mock := &MockLocker{ctrl: ctrl}
-	mock.recorder = &MockLockerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockLocker) EXPECT() *MockLockerMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZScore", reflect.TypeOf((*MockLockerClient)(nil).ZScore), arg0, arg1)
 }
 
-// Obtain mocks base method
-func (m *MockLocker) Obtain(arg0 string, arg1 time.Duration, arg2 go_extensions_redis.LockOptions) (go_extensions_redis.Lock, error) {
+// ZUnionStore mocks base method
+func (m *MockLockerClient) ZUnionStore(arg0 string, arg1 redis.ZStore, arg2 ...string) *redis.IntCmd {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Obtain", arg0, arg1, arg2)
-	ret0, _ := ret[0].(go_extensions_redis.Lock)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZUnionStore", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
 }
 
-// Obtain indicates an expected call of Obtain
-func (mr *MockLockerMockRecorder) Obtain(arg0, arg1, arg2 interface{}) *gomock.Call {
+// ZUnionStore indicates an expected call of ZUnionStore
+func (mr *MockLockerClientMockRecorder) ZUnionStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Obtain", reflect.TypeOf((*MockLocker)(nil).Obtain), arg0, arg1, arg2)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZUnionStore", reflect.TypeOf((*MockLockerClient)(nil).ZUnionStore), varargs...)
 }
 
 // MockMux is a mock of Mux interface
@@ -969,6 +6523,40 @@ func (mr *MockMuxMockRecorder) OnMany(arg0 interface{}, arg1 ...interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnMany", reflect.TypeOf((*MockMux)(nil).OnMany), varargs...)
 }
 
+// PSubscribe mocks base method
+func (m *MockMux) PSubscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PSubscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PSubscribe indicates an expected call of PSubscribe
+func (mr *MockMuxMockRecorder) PSubscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PSubscribe", reflect.TypeOf((*MockMux)(nil).PSubscribe), varargs...)
+}
+
+// Publish mocks base method
+func (m *MockMux) Publish(arg0 go_extensions_redis.Hash, arg1 string, arg2 interface{}) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockMuxMockRecorder) Publish(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockMux)(nil).Publish), arg0, arg1, arg2)
+}
+
 // SaveMapping mocks base method
 func (m *MockMux) SaveMapping(arg0 go_extensions_redis.Client, arg1 go_extensions_redis.Hash) go_extensions_redis.Client {
 	m.ctrl.T.Helper()
@@ -1002,6 +6590,26 @@ func (mr *MockMuxMockRecorder) SaveMappings(arg0, arg1 interface{}, arg2 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMappings", reflect.TypeOf((*MockMux)(nil).SaveMappings), varargs...)
 }
 
+// Subscribe mocks base method
+func (m *MockMux) Subscribe(arg0 context.Context, arg1 ...string) (*go_extensions_redis.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Subscribe", varargs...)
+	ret0, _ := ret[0].(*go_extensions_redis.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockMuxMockRecorder) Subscribe(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockMux)(nil).Subscribe), varargs...)
+}
+
 // WithContext mocks base method
 func (m *MockMux) WithContext(arg0 context.Context) go_extensions_redis.Mux {
 	m.ctrl.T.Helper()
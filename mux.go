@@ -2,8 +2,11 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	goredis "github.com/go-redis/redis"
@@ -24,8 +27,11 @@ type Mux interface {
 	InvalidateMany(...Hash) error
 	On(Hash) Client
 	OnMany(Hash, ...Hash) Client
+	PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error)
+	Publish(hash Hash, channel string, message interface{}) *goredis.IntCmd
 	SaveMapping(client Client, hash Hash) Client
 	SaveMappings(client Client, hash Hash, many ...Hash) Client
+	Subscribe(ctx context.Context, channels ...string) (*Subscription, error)
 	WithContext(context.Context) Mux
 }
 
@@ -35,6 +41,8 @@ type BaseMux struct {
 	addrClientMap map[string]Client
 	addrs         []string
 	clients       []Client
+	// ctx is the context HashClient operations and locks run under, HashClient's if nil
+	ctx           context.Context
 	hashClient    LockerClient
 	hashKeyPrefix string
 	hashMapTTL    time.Duration
@@ -61,7 +69,8 @@ type MuxOptions struct {
 	WithLockOnTTL time.Duration
 	// LockOptions are the LockOptions used by WithLockOn
 	// In case of a nil an ExponentialBackoff will be used with
-	// from 16ms to 64ms
+	// from 16ms to 64ms. Set LockOptions.Fair for hot hashes, so
+	// callers are granted the lock in FIFO order instead of racing for it
 	*LockOptions
 }
 
@@ -72,7 +81,7 @@ func NewMux(opt MuxOptions) (*BaseMux, error) {
 	addrs := make([]string, 0, len(opt.Clients))
 	addrClientMap := make(map[string]Client, len(opt.Clients))
 	for _, c := range opt.Clients {
-		addr := c.Options().Addr
+		addr := clientAddr(c)
 		addrClientMap[addr] = c
 		addrs = append(addrs, addr)
 	}
@@ -118,6 +127,7 @@ func (m BaseMux) WithContext(ctx context.Context) Mux {
 		addrClientMap: m.addrClientMap,
 		addrs:         m.addrs,
 		clients:       clients,
+		ctx:           ctx,
 		hashClient:    m.hashClient.WithContext(ctx).(LockerClient),
 		hashKeyPrefix: m.hashKeyPrefix,
 		hashMapTTL:    m.hashMapTTL,
@@ -130,10 +140,10 @@ func (m BaseMux) WithContext(ctx context.Context) Mux {
 // Validate MuxOptions
 func (o MuxOptions) Validate() error {
 	if o.HashClient == nil {
-		return fmt.Errorf("HashClient is required")
+		return wrapError(ErrInvalidOptions, errors.New("HashClient is required"))
 	}
 	if len(o.Clients) == 0 {
-		return fmt.Errorf("at least one client is required")
+		return wrapError(ErrInvalidOptions, errors.New("at least one client is required"))
 	}
 	return nil
 }
@@ -163,7 +173,11 @@ func (m BaseMux) on(hash Hash) Client {
 // BaseMux's implementation are called from methods that are holding a lock
 // for custom implementations, do it under a lock as well (e.g WithLockOn)
 func (m BaseMux) SaveMapping(client Client, hash Hash) Client {
-	if res := m.hashClient.Set(m.buildHashKey(hash), client.Options().Addr, m.hashMapTTL); res.Err() != nil {
+	addr, err := m.shardAddr(client)
+	if err != nil {
+		return NewErrClient(err)
+	}
+	if res := m.hash().Set(m.buildHashKey(hash), addr, m.hashMapTTL); res.Err() != nil {
 		return NewErrClient(res.Err())
 	}
 	return client
@@ -194,8 +208,12 @@ func (m BaseMux) OnMany(hash Hash, many ...Hash) Client {
 // SaveMappings BaseMux's implementation are called from methods that are holding a lock
 // for custom implementations, do it under a lock as well (e.g WithLockOn)
 func (m BaseMux) SaveMappings(client Client, hash Hash, many ...Hash) Client {
-	addr := client.Options().Addr
-	pipe := m.hashClient.TxPipeline()
+	addr, err := m.shardAddr(client)
+	if err != nil {
+		return NewErrClient(err)
+	}
+	hashClient := m.hash()
+	pipe := hashClient.TxPipeline()
 	pipe.PExpire(m.buildHashKey(hash), m.hashMapTTL)
 	pairs := make([]interface{}, len(many)*2)
 	for i := range many {
@@ -204,7 +222,7 @@ func (m BaseMux) SaveMappings(client Client, hash Hash, many ...Hash) Client {
 		pairs[2*i+1] = addr
 		pipe.PExpire(key, m.hashMapTTL)
 	}
-	if res := m.hashClient.MSet(pairs...); res.Err() != nil {
+	if res := hashClient.MSet(pairs...); res.Err() != nil {
 		client = NewErrClient(res.Err())
 	}
 	if m.hashMapTTL > 0 {
@@ -217,27 +235,130 @@ func (m BaseMux) SaveMappings(client Client, hash Hash, many ...Hash) Client {
 
 // WithLockOn runs a func `f` under a unique lock for `hash`
 func (m BaseMux) WithLockOn(hash Hash, f func()) error {
-	lock, err := m.hashClient.Obtain(hash.String(), m.withLockOnTTL, LockOptions{
+	lock, err := m.obtain(hash.String(), m.withLockOnTTL, LockOptions{
 		MinTime: m.lockOptions.MinTime,
 		MaxTime: m.lockOptions.MaxTime,
 		Limit:   m.lockOptions.Limit,
+		Fair:    m.lockOptions.Fair,
 	})
-	if lock == nil {
-		return fmt.Errorf("couldn't obtain lock for %v", hash)
-	}
 	if err != nil {
-		return err
+		return &LockError{Hash: hash, Err: err}
+	}
+	if lock == nil {
+		return &LockError{Hash: hash, Err: ErrLockNotObtained}
 	}
 	defer lock.Release()
 	f()
 	return nil
 }
 
+// WithLockOnContext is WithLockOn with the lock obtained under `ctx`,
+// which stops waiting for it once `ctx` is done
+func (m BaseMux) WithLockOnContext(ctx context.Context, hash Hash, f func()) error {
+	m.ctx = ctx
+	return m.WithLockOn(hash, f)
+}
+
+// ObtainContext tries to hold a lock over `key` on the HashClient during `ttl` duration,
+// retrying until `ctx` is done. It fails with ErrInvalidOptions if the HashClient isn't
+// a ContextObtainer. See ContextObtainer.ObtainContext
+func (m BaseMux) ObtainContext(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	return obtainContext(m.hashClient, ctx, key, ttl, opt)
+}
+
+// obtainerUnder obtains locks like Locker.Obtain under the context of each call,
+// instead of under the context of the client
+type obtainerUnder interface {
+	obtainUnder(ctx context.Context, key string, ttl time.Duration, opt LockOptions) (Lock, error)
+}
+
+// obtain is HashClient.Obtain under the BaseMux's context
+func (m BaseMux) obtain(key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	if m.ctx == nil {
+		return m.hashClient.Obtain(key, ttl, opt)
+	}
+	if obtainer, ok := m.hashClient.(obtainerUnder); ok {
+		return obtainer.obtainUnder(m.ctx, key, ttl, opt)
+	}
+	return m.hashClient.WithContext(m.ctx).(Locker).Obtain(key, ttl, opt)
+}
+
+// hashOps are the operations a BaseMux runs on its HashClient
+type hashOps interface {
+	Get(key string) *goredis.StringCmd
+	Set(key string, value interface{}, expiration time.Duration) *goredis.StatusCmd
+	MSet(pairs ...interface{}) *goredis.StatusCmd
+	Del(keys ...string) *goredis.IntCmd
+	TxPipeline() goredis.Pipeliner
+}
+
+// hash returns the HashClient to run operations on under the BaseMux's context.
+// Without a context, it's the HashClient itself. Otherwise BaseClient and ClusterClient
+// run them traced under the context without being copied, other clients on their WithContext copy
+func (m BaseMux) hash() hashOps {
+	if m.ctx == nil {
+		return m.hashClient
+	}
+	if processor, ok := m.hashClient.(contextProcessor); ok {
+		return processorHashOps{processor: processor, ctx: m.ctx}
+	}
+	return m.hashClient.WithContext(m.ctx)
+}
+
+// processorHashOps runs hashOps through a contextProcessor, traced under `ctx`
+type processorHashOps struct {
+	processor contextProcessor
+	ctx       context.Context
+}
+
+func (o processorHashOps) Get(key string) *goredis.StringCmd {
+	cmd := cmdBuilder.Get(key)
+	o.processor.processContext(o.ctx, cmd)
+	return cmd
+}
+
+func (o processorHashOps) Set(key string, value interface{}, expiration time.Duration) *goredis.StatusCmd {
+	cmd := cmdBuilder.Set(key, value, expiration)
+	o.processor.processContext(o.ctx, cmd)
+	return cmd
+}
+
+func (o processorHashOps) MSet(pairs ...interface{}) *goredis.StatusCmd {
+	cmd := cmdBuilder.MSet(pairs...)
+	o.processor.processContext(o.ctx, cmd)
+	return cmd
+}
+
+func (o processorHashOps) Del(keys ...string) *goredis.IntCmd {
+	cmd := cmdBuilder.Del(keys...)
+	o.processor.processContext(o.ctx, cmd)
+	return cmd
+}
+
+func (o processorHashOps) TxPipeline() goredis.Pipeliner {
+	return processorTxPipeline{Pipeliner: cmdBuilder.TxPipeline(), processor: o.processor, ctx: o.ctx}
+}
+
+// processorTxPipeline queues commands on a transaction of cmdBuilder,
+// running them through a contextProcessor on Exec
+type processorTxPipeline struct {
+	goredis.Pipeliner
+	processor contextProcessor
+	ctx       context.Context
+}
+
+func (p processorTxPipeline) Exec() ([]goredis.Cmder, error) {
+	cmds, _ := p.Pipeliner.Exec()
+	if len(cmds) == 0 {
+		return cmds, nil
+	}
+	return cmds, p.processor.processPipelineContext(p.ctx, cmds, true)
+}
+
 // Tries to find an existing mapping of hash <-> Client.
 // Returns `nil` if none exists.
 func (m BaseMux) onFromHashClient(hash Hash) Client {
-	key := m.buildHashKey(hash)
-	strCmd := m.hashClient.Get(key)
+	strCmd := m.hash().Get(m.buildHashKey(hash))
 	err := strCmd.Err()
 	if err != nil && err != goredis.Nil {
 		return NewErrClient(err)
@@ -256,6 +377,48 @@ func (m BaseMux) onFromHashClient(hash Hash) Client {
 	return cli
 }
 
+// shardAddr returns the address `client` is mapped by, failing with
+// ErrShardUnknown if it isn't one of the BaseMux's clients
+func (m BaseMux) shardAddr(client Client) (string, error) {
+	addr := clientAddr(client)
+	if addr == "" {
+		return "", ErrShardUnknown
+	}
+	if _, ok := m.addrClientMap[addr]; !ok {
+		return "", wrapError(ErrShardUnknown, fmt.Errorf("no client for address %s", addr))
+	}
+	return addr, nil
+}
+
+// sentinelClient is implemented by clients connected through Redis Sentinel (see NewFailoverClient)
+type sentinelClient interface {
+	MasterName() string
+	SentinelAddrs() []string
+}
+
+// clientAddr returns the address a BaseMux maps `client` by: its Sentinel master name
+// qualified by its sentinel addresses, which is stable across failovers and tells apart
+// deployments naming their masters alike, or else its address.
+// It's empty for clients without options
+func clientAddr(client Client) string {
+	if sentinel, ok := client.(sentinelClient); ok && sentinel.MasterName() != "" {
+		return sentinelAddr(sentinel.MasterName(), sentinel.SentinelAddrs())
+	}
+	opt := client.Options()
+	if opt == nil {
+		return ""
+	}
+	return opt.Addr
+}
+
+// sentinelAddr returns the mapping address of master `masterName` monitored by
+// the sentinels at `addrs`, e.g. "mymaster@10.0.0.1:26379,10.0.0.2:26379"
+func sentinelAddr(masterName string, addrs []string) string {
+	sorted := append([]string(nil), addrs...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("%s@%s", masterName, strings.Join(sorted, ","))
+}
+
 // buildHashKey adds the BaseMux's hashKeyPrefix to hash.String()
 func (m BaseMux) buildHashKey(hash Hash) string {
 	return fmt.Sprintf("%s%s", m.hashKeyPrefix, hash.String())
@@ -263,7 +426,7 @@ func (m BaseMux) buildHashKey(hash Hash) string {
 
 // Invalidate removes the mapping for a hash
 func (m BaseMux) Invalidate(hash Hash) error {
-	return m.hashClient.Del(m.buildHashKey(hash)).Err()
+	return m.hash().Del(m.buildHashKey(hash)).Err()
 }
 
 // InvalidateMany removes the mapping for `many` hashes
@@ -275,7 +438,40 @@ func (m BaseMux) InvalidateMany(many ...Hash) error {
 	for i := range many {
 		keys[i] = m.buildHashKey(many[i])
 	}
-	return m.hashClient.Del(keys...).Err()
+	return m.hash().Del(keys...).Err()
+}
+
+// Publish publishes `message` to `channel` on the Client `hash` is mapped to
+func (m BaseMux) Publish(hash Hash, channel string, message interface{}) *goredis.IntCmd {
+	return m.On(hash).Publish(channel, message)
+}
+
+// Subscribe subscribes to `channels` on all clients until `ctx` is done,
+// so messages published through any of them are received
+func (m BaseMux) Subscribe(ctx context.Context, channels ...string) (*Subscription, error) {
+	return m.subscribeAll(func(client Client) (*Subscription, error) {
+		return client.SubscribeContext(ctx, channels...)
+	})
+}
+
+// PSubscribe subscribes to channels matching `patterns` on all clients until `ctx` is done
+func (m BaseMux) PSubscribe(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return m.subscribeAll(func(client Client) (*Subscription, error) {
+		return client.PSubscribeContext(ctx, patterns...)
+	})
+}
+
+func (m BaseMux) subscribeAll(subscribe func(Client) (*Subscription, error)) (*Subscription, error) {
+	subs := make([]*Subscription, 0, len(m.clients))
+	for _, client := range m.clients {
+		sub, err := subscribe(client)
+		if err != nil {
+			mergeSubscriptions(subs...).Close()
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return mergeSubscriptions(subs...), nil
 }
 
 var _ Mux = (*BaseMux)(nil)
@@ -3,10 +3,13 @@
 package redis_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	goredis "github.com/go-redis/redis"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	redis "github.com/topfreegames/go-extensions-redis"
 	"github.com/topfreegames/go-extensions-redis/mocks"
@@ -41,7 +44,10 @@ func TestMux_WithLockOn(t *testing.T) {
 	err = mux.WithLockOn(hash, func() {
 		secondCallHappened = true
 	})
-	assert.Error(t, err)
+	assert.True(t, errors.Is(err, redis.ErrLockNotObtained))
+	var lockErr *redis.LockError
+	assert.True(t, errors.As(err, &lockErr))
+	assert.Equal(t, hash, lockErr.Hash)
 	assert.False(t, secondCallHappened)
 	ch <- true
 	err = mux.WithLockOn(hash, func() {
@@ -51,6 +57,92 @@ func TestMux_WithLockOn(t *testing.T) {
 	assert.True(t, secondCallHappened)
 }
 
+func TestMux_WithLockOn_ContextDeadline(t *testing.T) {
+	cliopt, err := goredis.ParseURL("redis://localhost:6666")
+	cliopt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(cliopt)
+	assert.Nil(t, err)
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient:  client,
+		Clients:     []redis.Client{client},
+		LockOptions: &redis.LockOptions{MinTime: 10 * time.Millisecond, MaxTime: 20 * time.Millisecond, Limit: 100},
+	})
+	assert.Nil(t, err)
+	hash := redis.Hash("deadline_hash")
+	lock, err := client.Obtain(hash.String(), time.Second, redis.DefaultLockOptions())
+	assert.Nil(t, err)
+	defer lock.Release()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	err = mux.WithContext(ctx).(*redis.BaseMux).WithLockOn(hash, func() {})
+	var lockErr *redis.LockError
+	assert.True(t, errors.As(err, &lockErr))
+	// the deadline passed while the lock was held, which isn't reported as contention
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.False(t, errors.Is(err, redis.ErrLockNotObtained))
+}
+
+func TestMux_WithLockOn_ReentrantWithOwner(t *testing.T) {
+	cliopt, err := goredis.ParseURL("redis://localhost:6666")
+	cliopt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(cliopt)
+	assert.Nil(t, err)
+	muxopt := redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	}
+	mux, err := redis.NewMux(muxopt)
+	assert.Nil(t, err)
+	cmd := client.FlushAll()
+	assert.Nil(t, cmd.Err())
+	hash := redis.Hash("some_hash")
+	ownerMux := mux.WithContext(redis.WithLockOwner(context.Background(), "owner")).(*redis.BaseMux)
+	innerCallHappened := false
+	err = ownerMux.WithLockOn(hash, func() {
+		err := ownerMux.WithLockOn(hash, func() {
+			innerCallHappened = true
+		})
+		assert.Nil(t, err)
+		// the outermost holder still holds the lock
+		err = mux.WithLockOn(hash, func() {})
+		assert.Error(t, err)
+	})
+	assert.Nil(t, err)
+	assert.True(t, innerCallHappened)
+	err = mux.WithLockOn(hash, func() {})
+	assert.Nil(t, err)
+}
+
+func TestNewMux_InvalidOptions(t *testing.T) {
+	_, err := redis.NewMux(redis.MuxOptions{})
+	assert.True(t, errors.Is(err, redis.ErrInvalidOptions))
+}
+
+func TestMux_SaveMapping_UnknownShard(t *testing.T) {
+	cliopt, err := goredis.ParseURL("redis://localhost:6666")
+	cliopt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(cliopt)
+	assert.Nil(t, err)
+	otheropt, err := goredis.ParseURL("redis://0.0.0.0:6666")
+	otheropt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	other, err := redis.NewClient(otheropt)
+	assert.Nil(t, err)
+	muxopt := redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	}
+	mux, err := redis.NewMux(muxopt)
+	assert.Nil(t, err)
+	hashCli := mux.SaveMapping(other, redis.Hash("some_hash"))
+	errCli, ok := hashCli.(*redis.ErrClient)
+	assert.True(t, ok)
+	assert.True(t, errors.Is(errCli.Err(), redis.ErrShardUnknown))
+}
+
 func TestMux_Invalidate(t *testing.T) {
 	cliopt, err := goredis.ParseURL("redis://localhost:6666")
 	cliopt.DialTimeout = 20 * time.Millisecond
@@ -227,3 +319,24 @@ func TestMux_OnMany_WithTTL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, dur.Milliseconds() >= 10, dur.Milliseconds() <= 15)
 }
+
+func TestMux_MockHashClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hashClient := mocks.NewMockLockerClient(ctrl)
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().Options().Return(&goredis.Options{Addr: "localhost:6666"}).AnyTimes()
+	mux, err := redis.NewMux(redis.MuxOptions{HashClient: hashClient, Clients: []redis.Client{client}})
+	assert.Nil(t, err)
+	// without a context, operations are HashClient method calls
+	hashClient.EXPECT().Get("hmk-some_hash").Return(goredis.NewStringResult("localhost:6666", nil))
+	hashClient.EXPECT().Del("hmk-some_hash").Return(goredis.NewIntResult(1, nil))
+	assert.Equal(t, client, mux.GetMapping(redis.Hash("some_hash")))
+	assert.Nil(t, mux.Invalidate(redis.Hash("some_hash")))
+	// under a context, they're method calls on its WithContext copy
+	ctx := context.Background()
+	hashCtxClient := mocks.NewMockClient(ctrl)
+	hashClient.EXPECT().WithContext(ctx).Return(hashCtxClient)
+	hashCtxClient.EXPECT().Del("hmk-some_hash").Return(goredis.NewIntResult(1, nil))
+	assert.Nil(t, redis.NewContextMux(mux).Invalidate(ctx, redis.Hash("some_hash")))
+}
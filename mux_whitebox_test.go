@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -187,3 +188,46 @@ func TestMux_OnManyShouldOverrideManyMappings(t *testing.T) {
 	assert.Equal(t, mux.onFromHashClient(many[0]), client0)
 	rand.Seed(time.Now().UnixNano())
 }
+
+func TestMux_MapsFailoverClientsByMasterName(t *testing.T) {
+	cliopt, err := goredis.ParseURL("redis://localhost:6666")
+	cliopt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := NewClient(cliopt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	failover := *client
+	failover.masterName = "mymaster"
+	failover.sentinelAddrs = []string{"sentinel1:26379", "sentinel0:26379"}
+	other := failover
+	other.sentinelAddrs = []string{"sentinel2:26379"}
+	mux, err := NewMux(MuxOptions{
+		HashClient: client,
+		Clients:    []Client{&failover, &other},
+	})
+	assert.Nil(t, err)
+	hash := Hash("some_hash")
+	assert.Equal(t, &failover, mux.SaveMapping(&failover, hash))
+	assert.Equal(t, &failover, mux.On(hash))
+	assert.Equal(t, "mymaster@sentinel0:26379,sentinel1:26379", client.Get(mux.buildHashKey(hash)).Val())
+	assert.Equal(t, "mymaster@sentinel0:26379,sentinel1:26379", clientAddr(failover.WithContext(context.Background())))
+	assert.Equal(t, "mymaster@sentinel2:26379", clientAddr(&other))
+	assert.Equal(t, &other, mux.SaveMapping(&other, hash))
+	assert.Equal(t, &other, mux.On(hash))
+}
+
+func TestNewFailoverClient_ConnectTimeout(t *testing.T) {
+	_, err := NewFailoverClient(&goredis.FailoverOptions{
+		MasterName:    "mymaster",
+		SentinelAddrs: []string{"localhost:6667"},
+		DialTimeout:   20 * time.Millisecond,
+	})
+	assert.True(t, errors.Is(err, ErrConnectTimeout))
+}
+
+func TestLockOptions_ZeroBackoffIsBounded(t *testing.T) {
+	strategy := LockOptions{}.jitterRetryStrategy()
+	for i := 0; i < 64; i++ {
+		assert.True(t, strategy.NextBackoff() <= DefaultLockOptions().MaxTime)
+	}
+}
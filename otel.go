@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the OpenTelemetry tracer of this package
+const instrumentationName = "github.com/topfreegames/go-extensions-redis"
+
+// OpenTelemetryTracer is a Tracer starting client spans on an OpenTelemetry trace.Tracer,
+// e.g. SetTracer(OpenTelemetryTracer{})
+type OpenTelemetryTracer struct {
+	// Tracer starting the spans, the global TracerProvider's if nil
+	Tracer oteltrace.Tracer
+}
+
+// StartSpan implements Tracer
+func (t OpenTelemetryTracer) StartSpan(ctx context.Context, name string, attrs Attributes) (context.Context, Span) {
+	tracer := t.Tracer
+	if tracer == nil {
+		tracer = otel.Tracer(instrumentationName)
+	}
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for key, value := range attrs {
+		kvs = append(kvs, otelAttribute(key, value))
+	}
+	ctx, span := tracer.Start(ctx, name,
+		oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+		oteltrace.WithAttributes(kvs...),
+	)
+	return ctx, otelSpan{span}
+}
+
+func otelAttribute(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+type otelSpan struct {
+	span oteltrace.Span
+}
+
+func (s otelSpan) SetAttribute(key string, value interface{}) {
+	s.span.SetAttributes(otelAttribute(key, value))
+}
+
+func (s otelSpan) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s otelSpan) End() {
+	s.span.End()
+}
+
+var _ Tracer = OpenTelemetryTracer{}
@@ -0,0 +1,112 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// PoolMetrics receives the connection pool statistics collected by a PoolStatsCollector,
+// implement it to feed gauges of your metrics backend, e.g. to alert on pool exhaustion
+// when Timeouts grows or IdleConns stays at zero
+type PoolMetrics interface {
+	// ObservePoolStats is called on each collection with the statistics of the pool to `addr`
+	ObservePoolStats(addr string, stats goredis.PoolStats)
+}
+
+// poolStatser is a client that tracks connection pool statistics,
+// i.e. BaseClient and ClusterClient
+type poolStatser interface {
+	PoolStats() *goredis.PoolStats
+}
+
+// pooler is a client that identifies its connection pool, shared by its WithContext copies,
+// i.e. BaseClient and ClusterClient
+type pooler interface {
+	pool() interface{}
+}
+
+// pool returns the options of c, shared by its connection pool and WithContext copies
+func (c BaseClient) pool() interface{} {
+	return c.Client.Options()
+}
+
+// pool returns the options of c, shared by its connection pools and WithContext copies
+func (c ClusterClient) pool() interface{} {
+	return c.ClusterOptions()
+}
+
+// PoolStatsCollector collects the connection pool statistics of clients by address
+type PoolStatsCollector struct {
+	clients func() []Client
+	metrics PoolMetrics
+}
+
+// NewPoolStatsCollector returns a PoolStatsCollector over `clients`, reporting to `metrics`.
+// Clients that don't track pool statistics, e.g. ErrClient, are skipped
+func NewPoolStatsCollector(metrics PoolMetrics, clients ...Client) *PoolStatsCollector {
+	return &PoolStatsCollector{
+		clients: func() []Client { return clients },
+		metrics: metrics,
+	}
+}
+
+// NewMuxPoolStatsCollector returns a PoolStatsCollector over all clients of `mux`, see Mux.All
+func NewMuxPoolStatsCollector(metrics PoolMetrics, mux Mux) *PoolStatsCollector {
+	return &PoolStatsCollector{
+		clients: mux.All,
+		metrics: metrics,
+	}
+}
+
+// Collect returns the pool statistics of each client address, reporting them to PoolMetrics.
+// Separate pools to the same address, e.g. of clients to different DBs, are summed up,
+// while a pool shared by several clients, e.g. WithContext copies, is counted once
+func (c *PoolStatsCollector) Collect() map[string]goredis.PoolStats {
+	stats := make(map[string]goredis.PoolStats)
+	pools := make(map[interface{}]bool)
+	for _, client := range c.clients() {
+		statser, ok := client.(poolStatser)
+		if !ok {
+			continue
+		}
+		if p, ok := client.(pooler); ok {
+			if pools[p.pool()] {
+				continue
+			}
+			pools[p.pool()] = true
+		}
+		addr := clientAddr(client)
+		stats[addr] = addPoolStats(stats[addr], *statser.PoolStats())
+	}
+	for addr, s := range stats {
+		c.metrics.ObservePoolStats(addr, s)
+	}
+	return stats
+}
+
+func addPoolStats(a, b goredis.PoolStats) goredis.PoolStats {
+	return goredis.PoolStats{
+		Hits:       a.Hits + b.Hits,
+		Misses:     a.Misses + b.Misses,
+		Timeouts:   a.Timeouts + b.Timeouts,
+		TotalConns: a.TotalConns + b.TotalConns,
+		IdleConns:  a.IdleConns + b.IdleConns,
+		StaleConns: a.StaleConns + b.StaleConns,
+	}
+}
+
+// Run collects pool statistics every `interval` until `ctx` is done
+func (c *PoolStatsCollector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Collect()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+type poolMetricsRecorder map[string]goredis.PoolStats
+
+func (r poolMetricsRecorder) ObservePoolStats(addr string, stats goredis.PoolStats) {
+	r[addr] = stats
+}
+
+func TestPoolStatsCollector(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.Set("key", "value", 0).Err())
+	metrics := poolMetricsRecorder{}
+	collector := redis.NewPoolStatsCollector(metrics, client, redis.NewErrClient(errors.New("down")))
+	stats := collector.Collect()
+	assert.Len(t, stats, 1)
+	assert.True(t, stats["localhost:6666"].Hits > 0)
+	assert.True(t, stats["localhost:6666"].TotalConns > 0)
+	assert.Equal(t, stats["localhost:6666"], metrics["localhost:6666"])
+}
+
+func TestPoolStatsCollector_SameAddress(t *testing.T) {
+	var clients []redis.Client
+	var hits uint32
+	for _, url := range []string{"redis://localhost:6666/0", "redis://localhost:6666/1"} {
+		opt, err := goredis.ParseURL(url)
+		assert.Nil(t, err)
+		opt.DialTimeout = 20 * time.Millisecond
+		client, err := redis.NewClient(opt)
+		assert.Nil(t, err)
+		assert.Nil(t, client.Set("key", "value", 0).Err())
+		hits += client.PoolStats().Hits
+		clients = append(clients, client, client.WithContext(context.Background()))
+	}
+	stats := redis.NewPoolStatsCollector(poolMetricsRecorder{}, clients...).Collect()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, hits, stats["localhost:6666"].Hits)
+	assert.Equal(t, uint32(2), stats["localhost:6666"].TotalConns)
+}
+
+func TestMuxPoolStatsCollector_Run(t *testing.T) {
+	cli0opt, err := goredis.ParseURL("redis://localhost:6666")
+	cli0opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	cli1opt, err := goredis.ParseURL("redis://0.0.0.0:6666")
+	cli1opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client0, err := redis.NewClient(cli0opt)
+	assert.Nil(t, err)
+	client1, err := redis.NewClient(cli1opt)
+	assert.Nil(t, err)
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client0,
+		Clients:    []redis.Client{client0, client1},
+	})
+	assert.Nil(t, err)
+	metrics := poolMetricsRecorder{}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	redis.NewMuxPoolStatsCollector(metrics, mux).Run(ctx, 10*time.Millisecond)
+	assert.Len(t, metrics, 2)
+	assert.Contains(t, metrics, "0.0.0.0:6666")
+}
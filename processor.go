@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// cmdBuilder builds the commands of ContextClient without running them, which is left
+// to the contextProcessor of its client. It never connects anywhere
+var cmdBuilder = newCmdBuilder()
+
+func newCmdBuilder() *goredis.Client {
+	client := goredis.NewClient(&goredis.Options{
+		// no idle connections reaper
+		IdleTimeout: -1,
+	})
+	client.WrapProcess(func(func(goredis.Cmder) error) func(goredis.Cmder) error {
+		return func(goredis.Cmder) error {
+			return nil
+		}
+	})
+	client.WrapProcessPipeline(func(func([]goredis.Cmder) error) func([]goredis.Cmder) error {
+		return func([]goredis.Cmder) error {
+			return nil
+		}
+	})
+	return client
+}
+
+// contextProcessor runs commands, pipelines and transactions traced under
+// the context given on each call, instead of the context of its client
+type contextProcessor interface {
+	processContext(ctx context.Context, cmd goredis.Cmder) error
+	// processPipelineContext runs `cmds` as a pipeline, or as a transaction if `tx`
+	processPipelineContext(ctx context.Context, cmds []goredis.Cmder, tx bool) error
+	watchContext(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error
+}
+
+func (c BaseClient) processContext(ctx context.Context, cmd goredis.Cmder) error {
+	return c.instrumentation.processContext(ctx, cmd)
+}
+
+func (c BaseClient) processPipelineContext(ctx context.Context, cmds []goredis.Cmder, tx bool) error {
+	return c.instrumentation.processPipelineContext(ctx, cmds, tx)
+}
+
+func (c BaseClient) watchContext(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error {
+	return c.Client.Watch(func(tx *goredis.Tx) error {
+		c.instrumentation.instrumentTx(tx, ctx)
+		return fn(tx)
+	}, keys...)
+}
+
+func (c ClusterClient) processContext(ctx context.Context, cmd goredis.Cmder) error {
+	return c.instrumentation.processContext(ctx, cmd)
+}
+
+func (c ClusterClient) processPipelineContext(ctx context.Context, cmds []goredis.Cmder, tx bool) error {
+	return c.instrumentation.processPipelineContext(ctx, cmds, tx)
+}
+
+func (c ClusterClient) watchContext(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error {
+	return c.ClusterClient.Watch(func(tx *goredis.Tx) error {
+		c.instrumentation.instrumentTx(tx, ctx)
+		return fn(tx)
+	}, keys...)
+}
+
+// clientProcessor is the contextProcessor of clients that aren't BaseClient
+// or ClusterClient, running on their WithContext copies
+type clientProcessor struct {
+	client Client
+}
+
+func (p clientProcessor) processContext(ctx context.Context, cmd goredis.Cmder) error {
+	return p.client.WithContext(ctx).Process(cmd)
+}
+
+func (p clientProcessor) processPipelineContext(ctx context.Context, cmds []goredis.Cmder, tx bool) error {
+	client := p.client.WithContext(ctx)
+	pipe := client.Pipeline()
+	if tx {
+		pipe = client.TxPipeline()
+	}
+	for _, cmd := range cmds {
+		pipe.Process(cmd)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+func (p clientProcessor) watchContext(ctx context.Context, fn func(*goredis.Tx) error, keys ...string) error {
+	return p.client.WithContext(ctx).Watch(fn, keys...)
+}
+
+var (
+	_ contextProcessor = BaseClient{}
+	_ contextProcessor = ClusterClient{}
+)
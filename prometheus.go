@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusMetrics is a CommandMetrics exporting Prometheus histograms of command and
+// pipeline latency and counters of their errors, labelled by addr and db. Commands are
+// also labelled by command. Pipelines aren't, since they mix commands, but their number
+// of commands is recorded in a histogram instead.
+// Register it to a prometheus.Registerer, it's a prometheus.Collector
+type PrometheusMetrics struct {
+	commandDuration  *prometheus.HistogramVec
+	commandErrors    *prometheus.CounterVec
+	pipelineDuration *prometheus.HistogramVec
+	pipelineErrors   *prometheus.CounterVec
+	pipelineCommands *prometheus.HistogramVec
+}
+
+// NewPrometheusMetrics returns a PrometheusMetrics with metrics in `namespace`,
+// e.g. namespace_redis_command_duration_seconds. Latency histograms use `buckets`,
+// prometheus.DefBuckets if it's empty
+func NewPrometheusMetrics(namespace string, buckets []float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+	return &PrometheusMetrics{
+		commandDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "command_duration_seconds",
+			Help:      "Latency of Redis commands",
+			Buckets:   buckets,
+		}, []string{"command", "addr", "db"}),
+		commandErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "command_errors_total",
+			Help:      "Redis commands that failed",
+		}, []string{"command", "addr", "db"}),
+		pipelineDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "pipeline_duration_seconds",
+			Help:      "Latency of Redis pipelines and transactions",
+			Buckets:   buckets,
+		}, []string{"addr", "db"}),
+		pipelineErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "pipeline_errors_total",
+			Help:      "Redis pipelines and transactions that failed",
+		}, []string{"addr", "db"}),
+		pipelineCommands: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "pipeline_commands",
+			Help:      "Number of commands of Redis pipelines and transactions",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, []string{"addr", "db"}),
+	}
+}
+
+// ObserveCommand implements CommandMetrics
+func (m *PrometheusMetrics) ObserveCommand(command, addr string, db int, duration time.Duration, err error) {
+	labels := prometheus.Labels{"command": command, "addr": addr, "db": strconv.Itoa(db)}
+	m.commandDuration.With(labels).Observe(duration.Seconds())
+	if err != nil {
+		m.commandErrors.With(labels).Inc()
+	}
+}
+
+// ObservePipeline implements CommandMetrics
+func (m *PrometheusMetrics) ObservePipeline(commands int, addr string, db int, duration time.Duration, err error) {
+	labels := prometheus.Labels{"addr": addr, "db": strconv.Itoa(db)}
+	m.pipelineDuration.With(labels).Observe(duration.Seconds())
+	m.pipelineCommands.With(labels).Observe(float64(commands))
+	if err != nil {
+		m.pipelineErrors.With(labels).Inc()
+	}
+}
+
+// Describe implements prometheus.Collector
+func (m *PrometheusMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.commandDuration.Describe(ch)
+	m.commandErrors.Describe(ch)
+	m.pipelineDuration.Describe(ch)
+	m.pipelineErrors.Describe(ch)
+	m.pipelineCommands.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (m *PrometheusMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.commandDuration.Collect(ch)
+	m.commandErrors.Collect(ch)
+	m.pipelineDuration.Collect(ch)
+	m.pipelineErrors.Collect(ch)
+	m.pipelineCommands.Collect(ch)
+}
+
+var _ CommandMetrics = (*PrometheusMetrics)(nil)
+var _ prometheus.Collector = (*PrometheusMetrics)(nil)
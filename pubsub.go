@@ -0,0 +1,131 @@
+package redis
+
+import (
+	"context"
+	"sync"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// Subscription is a Pub/Sub subscription, possibly over many clients, that lasts
+// until its context is done or it's closed. Connections lost are reestablished
+// and resubscribed by go-redis
+type Subscription struct {
+	pubsubs     []*goredis.PubSub
+	dones       []chan struct{}
+	stops       []chan struct{}
+	closeOnce   sync.Once
+	channelOnce sync.Once
+	msgs        chan *goredis.Message
+}
+
+// newSubscription waits for `pubsub` subscription confirmation and closes it when `ctx` is done
+func newSubscription(ctx context.Context, pubsub *goredis.PubSub) (*Subscription, error) {
+	if _, err := pubsub.Receive(); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	done := make(chan struct{})
+	// stop is closed once the subscription is closed or ctx is done
+	stop := make(chan struct{})
+	go func() {
+		defer close(stop)
+		select {
+		case <-ctx.Done():
+			pubsub.Close()
+		case <-done:
+		}
+	}()
+	return &Subscription{
+		pubsubs: []*goredis.PubSub{pubsub},
+		dones:   []chan struct{}{done},
+		stops:   []chan struct{}{stop},
+	}, nil
+}
+
+// mergeSubscriptions returns a Subscription receiving the messages of all `subs`
+func mergeSubscriptions(subs ...*Subscription) *Subscription {
+	merged := &Subscription{}
+	for _, sub := range subs {
+		merged.pubsubs = append(merged.pubsubs, sub.pubsubs...)
+		merged.dones = append(merged.dones, sub.dones...)
+		merged.stops = append(merged.stops, sub.stops...)
+	}
+	return merged
+}
+
+// Channel returns a channel of the messages received, closed when the Subscription
+// is closed or its context is done. Messages not read by then are dropped
+func (s *Subscription) Channel() <-chan *goredis.Message {
+	s.channelOnce.Do(func() {
+		s.msgs = make(chan *goredis.Message, 100)
+		var wg sync.WaitGroup
+		wg.Add(len(s.pubsubs))
+		for i, pubsub := range s.pubsubs {
+			go func(ch <-chan *goredis.Message, stop <-chan struct{}) {
+				defer wg.Done()
+				for msg := range ch {
+					select {
+					case s.msgs <- msg:
+					case <-stop:
+						return
+					}
+				}
+			}(pubsub.Channel(), s.stops[i])
+		}
+		go func() {
+			wg.Wait()
+			close(s.msgs)
+		}()
+	})
+	return s.msgs
+}
+
+// Close unsubscribes and closes the connections of the Subscription
+func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		for _, done := range s.dones {
+			close(done)
+		}
+		for _, pubsub := range s.pubsubs {
+			if cerr := pubsub.Close(); cerr != nil {
+				err = cerr
+			}
+		}
+	})
+	return err
+}
+
+// SubscribeContext subscribes to `channels` until `ctx` is done
+func (c BaseClient) SubscribeContext(ctx context.Context, channels ...string) (*Subscription, error) {
+	return subscribe(ctx, c.instrumentation.addr, c.db, "redis subscribe", func() *goredis.PubSub {
+		return c.Client.Subscribe(channels...)
+	})
+}
+
+// PSubscribeContext subscribes to channels matching `patterns` until `ctx` is done
+func (c BaseClient) PSubscribeContext(ctx context.Context, patterns ...string) (*Subscription, error) {
+	return subscribe(ctx, c.instrumentation.addr, c.db, "redis psubscribe", func() *goredis.PubSub {
+		return c.Client.PSubscribe(patterns...)
+	})
+}
+
+func subscribe(
+	ctx context.Context,
+	addr string,
+	db int,
+	operationName string,
+	pubsub func() *goredis.PubSub,
+) (*Subscription, error) {
+	var sub *Subscription
+	err := trace(ctx, operationName, dbAttributes(addr, db), func() error {
+		var err error
+		sub, err = newSubscription(ctx, pubsub())
+		return err
+	})
+	return sub, err
+}
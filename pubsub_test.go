@@ -0,0 +1,88 @@
+package redis_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestClient_SubscribeContext(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := client.SubscribeContext(ctx, "some_channel")
+	assert.Nil(t, err)
+	ch := sub.Channel()
+	assert.Nil(t, client.Publish("some_channel", "hello").Err())
+	select {
+	case msg := <-ch:
+		assert.Equal(t, "some_channel", msg.Channel)
+		assert.Equal(t, "hello", msg.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("message not received")
+	}
+	cancel()
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed after context is done")
+	}
+}
+
+func TestClient_SubscribeContext_NotRead(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	goroutines := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := client.SubscribeContext(ctx, "unread_channel")
+	assert.Nil(t, err)
+	ch := sub.Channel()
+	for i := 0; i < cap(ch)+10; i++ {
+		assert.Nil(t, client.Publish("unread_channel", "hello").Err())
+	}
+	assert.Eventually(t, func() bool { return len(ch) == cap(ch) }, time.Second, time.Millisecond)
+	// nobody reads the messages after the context is done, which doesn't leak goroutines
+	cancel()
+	// plus the goroutine Eventually runs its condition in
+	assert.Eventually(t, func() bool { return runtime.NumGoroutine() <= goroutines+1 }, time.Second, time.Millisecond)
+}
+
+func TestMux_PublishAndSubscribe(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	})
+	assert.Nil(t, err)
+	sub, err := mux.PSubscribe(context.Background(), "some_*")
+	assert.Nil(t, err)
+	defer sub.Close()
+	assert.Nil(t, mux.Publish(redis.Hash("some_hash"), "some_channel", "hello").Err())
+	select {
+	case msg := <-sub.Channel():
+		assert.Equal(t, "some_channel", msg.Channel)
+		assert.Equal(t, "hello", msg.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("message not received")
+	}
+	errCli := redis.NewErrClient(redis.ErrShardUnknown)
+	_, err = errCli.SubscribeContext(context.Background(), "some_channel")
+	assert.Equal(t, redis.ErrShardUnknown, err)
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"sync"
+)
+
+// RecordedSpan is a span ended on a SpanRecorder
+type RecordedSpan struct {
+	Name       string
+	Attributes Attributes
+	// Err is the error recorded on the span, if any
+	Err error
+	// Parent is the span it's a child of, nil if it's a root span
+	Parent *RecordedSpan
+}
+
+// SpanRecorder is a Tracer keeping spans in memory, for tests
+type SpanRecorder struct {
+	mu    sync.Mutex
+	ended []*RecordedSpan
+}
+
+// NewSpanRecorder returns an empty SpanRecorder
+func NewSpanRecorder() *SpanRecorder {
+	return &SpanRecorder{}
+}
+
+type recordedSpanKey struct{}
+
+// StartSpan implements Tracer
+func (r *SpanRecorder) StartSpan(ctx context.Context, name string, attrs Attributes) (context.Context, Span) {
+	span := &RecordedSpan{Name: name, Attributes: Attributes{}}
+	for key, value := range attrs {
+		span.Attributes[key] = value
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	span.Parent, _ = ctx.Value(recordedSpanKey{}).(*RecordedSpan)
+	return context.WithValue(ctx, recordedSpanKey{}, span), &recordingSpan{recorder: r, span: span}
+}
+
+// Ended returns the spans ended so far, in the order they ended
+func (r *SpanRecorder) Ended() []*RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*RecordedSpan(nil), r.ended...)
+}
+
+// Reset forgets the spans ended so far
+func (r *SpanRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ended = nil
+}
+
+type recordingSpan struct {
+	recorder *SpanRecorder
+	span     *RecordedSpan
+}
+
+func (s *recordingSpan) SetAttribute(key string, value interface{}) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.span.Attributes[key] = value
+}
+
+func (s *recordingSpan) RecordError(err error) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.span.Err = err
+}
+
+func (s *recordingSpan) End() {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+	s.recorder.ended = append(s.recorder.ended, s.span)
+}
+
+var _ Tracer = (*SpanRecorder)(nil)
@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// reentrantObtain takes the lock if `key` is free or already held by the
+// owner ARGV[1], incrementing its hold count and refreshing its TTL ARGV[2]
+var reentrantObtain = goredis.NewScript(`
+local t = redis.call("type", KEYS[1]).ok
+if t == "none" or (t == "hash" and redis.call("hexists", KEYS[1], ARGV[1]) == 1) then
+	redis.call("hincrby", KEYS[1], ARGV[1], 1)
+	redis.call("pexpire", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// reentrantRelease decrements the hold count of owner ARGV[1] and deletes
+// `key` when it reaches zero. Returns -1 if the lock isn't held by the owner
+var reentrantRelease = goredis.NewScript(`
+if redis.call("type", KEYS[1]).ok ~= "hash" or redis.call("hexists", KEYS[1], ARGV[1]) == 0 then
+	return -1
+end
+local count = redis.call("hincrby", KEYS[1], ARGV[1], -1)
+if count <= 0 then
+	redis.call("del", KEYS[1])
+end
+return count
+`)
+
+type lockOwnerKey struct{}
+
+// WithLockOwner returns a copy of ctx carrying `owner` as the lock owner.
+// Clients running under this context obtain reentrant locks, so the same
+// owner can obtain a lock it's already holding
+func WithLockOwner(ctx context.Context, owner string) context.Context {
+	return context.WithValue(ctx, lockOwnerKey{}, owner)
+}
+
+// LockOwner returns the lock owner set in ctx by WithLockOwner, if any
+func LockOwner(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	owner, ok := ctx.Value(lockOwnerKey{}).(string)
+	return owner, ok && owner != ""
+}
+
+// reentrantLock is a Lock held by `owner`, it's only freed
+// after Release is called as many times as it was obtained
+type reentrantLock struct {
+	client goredis.Cmdable
+	key    string
+	owner  string
+}
+
+// Release decrements the hold count of the lock, freeing it on the outermost Release
+func (l *reentrantLock) Release() error {
+	res, err := reentrantRelease.Run(l.client, []string{l.key}, l.owner).Int64()
+	if err != nil {
+		return err
+	}
+	if res < 0 {
+		return ErrLockNotHeld
+	}
+	return nil
+}
+
+func (l *reentrantLock) releaseThrough(client goredis.Cmdable) error {
+	cpy := *l
+	cpy.client = client
+	return cpy.Release()
+}
+
+// ObtainReentrant tries to hold a lock over `key` on behalf of `owner` during `ttl` duration.
+// If `owner` is already holding it, its hold count is incremented and its TTL refreshed
+func (c lockClient) ObtainReentrant(owner, key string, ttl time.Duration, opt LockOptions) (Lock, error) {
+	return c.traceObtain(c.ctx, key, ttl, func(ctx context.Context, stats *lockStats) (Lock, error) {
+		return c.traced(ctx).obtainReentrant(owner, key, ttl, opt, stats)
+	})
+}
+
+func (c lockClient) obtainReentrant(
+	owner, key string,
+	ttl time.Duration,
+	opt LockOptions,
+	stats *lockStats,
+) (Lock, error) {
+	rlopt := opt.toRedisLockOptions()
+	retry := countRetries(rlopt.RetryStrategy, stats)
+	return retryObtain(c.ctx, retry, time.Now().Add(ttl), func() (Lock, error) {
+		return c.tryObtainReentrant(owner, key, ttl)
+	})
+}
+
+// tryObtainReentrant makes a single attempt to obtain a reentrant lock,
+// returning (nil, nil) if it's held by another owner
+func (c lockClient) tryObtainReentrant(owner, key string, ttl time.Duration) (Lock, error) {
+	ok, err := reentrantObtain.Run(c.client, []string{key}, owner, ttl.Milliseconds()).Int64()
+	if err != nil || ok != 1 {
+		return nil, err
+	}
+	return &reentrantLock{client: c.client, key: key, owner: owner}, nil
+}
@@ -0,0 +1,106 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// Script is a named Lua script run through EVALSHA, loading it into the server
+// and retrying when it isn't cached there yet (NOSCRIPT error)
+type Script struct {
+	name string
+	src  string
+	hash string
+}
+
+// NewScript returns a Script called `name` running the Lua source `src`.
+// `name` is used as the operation name of the spans of its runs
+func NewScript(name, src string) *Script {
+	h := sha1.New()
+	h.Write([]byte(src))
+	return &Script{
+		name: name,
+		src:  src,
+		hash: hex.EncodeToString(h.Sum(nil)),
+	}
+}
+
+// Name returns the name of the Script
+func (s *Script) Name() string {
+	return s.name
+}
+
+// Hash returns the SHA1 of the Script source, as used by EVALSHA
+func (s *Script) Hash() string {
+	return s.hash
+}
+
+// Load loads the Script into the server of `client`
+func (s *Script) Load(client Client) error {
+	return client.ScriptLoad(s.src).Err()
+}
+
+// Preload loads the Script into the servers of all clients of `mux`,
+// so its first runs don't need to fall back to loading it
+func (s *Script) Preload(mux Mux) error {
+	for _, client := range mux.All() {
+		if err := s.Load(client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run runs the Script on `client` through EVALSHA, loading it and retrying
+// once if the server doesn't have it cached
+func (s *Script) Run(client Client, keys []string, args ...interface{}) *goredis.Cmd {
+	db := 0
+	if opt := client.Options(); opt != nil {
+		db = opt.DB
+	}
+	attrs := dbAttributes(clientAddr(client), db)
+	attrs["db.operation"] = "evalsha"
+	attrs["db.script"] = s.hash
+	var cmd *goredis.Cmd
+	traceSpan(client.Context(), "redis script "+s.name, attrs, func(ctx context.Context, _ Span) error {
+		cmd = s.evalSha(ctx, client, keys, args...)
+		if err := cmd.Err(); err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT") {
+			if err := s.load(ctx, client); err != nil {
+				cmd = goredis.NewCmdResult(nil, err)
+				return err
+			}
+			cmd = s.evalSha(ctx, client, keys, args...)
+		}
+		if err := cmd.Err(); err != nil && err != goredis.Nil {
+			return err
+		}
+		return nil
+	})
+	return cmd
+}
+
+// evalSha runs the Script on `client` through EVALSHA. Its span is a child of the span of `ctx`
+// if `client` is a BaseClient or a ClusterClient, which can trace commands under another context
+func (s *Script) evalSha(ctx context.Context, client Client, keys []string, args ...interface{}) *goredis.Cmd {
+	processor, ok := client.(contextProcessor)
+	if !ok || ctx == nil {
+		return client.EvalSha(s.hash, keys, args...)
+	}
+	cmd := cmdBuilder.EvalSha(s.hash, keys, args...)
+	processor.processContext(ctx, cmd)
+	return cmd
+}
+
+// load is Load traced under `ctx` like evalSha
+func (s *Script) load(ctx context.Context, client Client) error {
+	processor, ok := client.(contextProcessor)
+	if !ok || ctx == nil {
+		return s.Load(client)
+	}
+	cmd := cmdBuilder.ScriptLoad(s.src)
+	return processor.processContext(ctx, cmd)
+}
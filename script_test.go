@@ -0,0 +1,75 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+var incrTwice = redis.NewScript("incr twice", `
+redis.call("incr", KEYS[1])
+return redis.call("incr", KEYS[1])
+`)
+
+func TestScript_Run(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	assert.Nil(t, client.ScriptFlush().Err())
+	assert.Equal(t, []bool{false}, client.ScriptExists(incrTwice.Hash()).Val())
+	res, err := incrTwice.Run(client, []string{"counter"}).Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), res)
+	assert.Equal(t, []bool{true}, client.ScriptExists(incrTwice.Hash()).Val())
+	errCli := redis.NewErrClient(redis.ErrShardUnknown)
+	assert.Equal(t, redis.ErrShardUnknown, incrTwice.Run(errCli, []string{"counter"}).Err())
+}
+
+func TestScript_RunTracesUnderScriptSpan(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.ScriptFlush().Err())
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	ctx, parent := recorder.StartSpan(context.Background(), "parent", nil)
+	assert.Nil(t, incrTwice.Run(client.WithContext(ctx), []string{"counter"}).Err())
+	parent.End()
+	spans := recorder.Ended()
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name)
+	}
+	// evalsha, script load after NOSCRIPT, evalsha again
+	assert.Equal(t, []string{"redis evalsha", "redis script", "redis evalsha", "redis script incr twice", "parent"}, names)
+	script := spans[3]
+	assert.Equal(t, spans[4], script.Parent)
+	for _, span := range spans[:3] {
+		assert.Equal(t, script, span.Parent, span.Name)
+	}
+}
+
+func TestScript_Preload(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.ScriptFlush().Err())
+	mux, err := redis.NewMux(redis.MuxOptions{
+		HashClient: client,
+		Clients:    []redis.Client{client},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, incrTwice.Preload(mux))
+	assert.Equal(t, []bool{true}, client.ScriptExists(incrTwice.Hash()).Val())
+}
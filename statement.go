@@ -0,0 +1,218 @@
+package redis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// Redaction sets which arguments of a command are kept in the db.statement attribute
+// of its span, see StatementOptions
+type Redaction int
+
+const (
+	// RedactNone keeps all arguments
+	RedactNone Redaction = iota
+	// RedactValues keeps only the keys, replacing other arguments with "?"
+	RedactValues
+	// RedactArgs keeps only the command name
+	RedactArgs
+)
+
+// redacted replaces the redacted arguments in statements
+const redacted = "?"
+
+// truncated ends truncated statements
+const truncated = "..."
+
+// StatementOptions configures the db.statement attribute of the spans of commands and pipelines
+type StatementOptions struct {
+	// Redaction of the command arguments
+	// Default: RedactNone
+	Redaction Redaction
+	// Masks are patterns replaced with "?" in the arguments kept by Redaction.
+	// They're matched against whole arguments, before they're cut to the length left
+	// by MaxLength or MaxPipelineLength, so a cut secret is still masked
+	Masks []*regexp.Regexp
+	// MaxLength is the maximum length in bytes of the statement of a command,
+	// it's truncated ending in "..." if longer. Arguments past it aren't formatted
+	// Default: 0, no limit
+	MaxLength int
+	// MaxPipelineLength is the maximum length in bytes of the statement of a pipeline,
+	// i.e. its commands' statements one per line, like MaxLength
+	// Default: 0, no limit
+	MaxPipelineLength int
+}
+
+// SetStatementOptions sets how the statements of commands run through this client and
+// every client returned by its WithContext are traced. It's safe to call while commands run
+func (c *BaseClient) SetStatementOptions(opt StatementOptions) {
+	c.instrumentation.statements.Store(opt)
+}
+
+// SetStatementOptions sets how the statements of commands run through this client and
+// every client returned by its WithContext are traced. It's safe to call while commands run
+func (c *ClusterClient) SetStatementOptions(opt StatementOptions) {
+	c.instrumentation.statements.Store(opt)
+}
+
+// command returns the statement of `cmd`
+func (o StatementOptions) command(cmd goredis.Cmder) string {
+	limit := -1
+	if o.MaxLength > 0 {
+		limit = o.MaxLength
+	}
+	return o.commandUpTo(cmd, limit)
+}
+
+// commandUpTo returns the statement of `cmd` truncated to MaxLength, formatting
+// its arguments only up to `limit` bytes, or all of them if `limit` is negative
+func (o StatementOptions) commandUpTo(cmd goredis.Cmder, limit int) string {
+	if o.Redaction == RedactArgs {
+		return truncate(cmd.Name(), o.MaxLength)
+	}
+	args := cmd.Args()
+	keys := commandKeys(cmd.Name(), args)
+	var b strings.Builder
+	b.WriteString(cmd.Name())
+	for i := 1; i < len(args); i++ {
+		if limit >= 0 && b.Len() > limit {
+			break
+		}
+		b.WriteByte(' ')
+		if o.Redaction == RedactValues && !keys(i) {
+			b.WriteString(redacted)
+			continue
+		}
+		max := -1
+		if limit >= 0 {
+			// one byte past the limit, so it's truncated
+			max = limit + 1 - b.Len()
+		}
+		b.WriteString(o.formatArg(args[i], max))
+	}
+	return truncate(b.String(), o.MaxLength)
+}
+
+// pipeline returns the statement of `cmds`, one command per line
+func (o StatementOptions) pipeline(cmds []goredis.Cmder) string {
+	var b strings.Builder
+	for idx, cmd := range cmds {
+		if o.MaxPipelineLength > 0 && b.Len() > o.MaxPipelineLength {
+			break
+		}
+		if idx > 0 {
+			b.WriteByte('\n')
+		}
+		limit := -1
+		if o.MaxLength > 0 {
+			limit = o.MaxLength
+		}
+		if o.MaxPipelineLength > 0 {
+			left := o.MaxPipelineLength - b.Len()
+			if left < 0 {
+				left = 0
+			}
+			if limit < 0 || left < limit {
+				limit = left
+			}
+		}
+		b.WriteString(o.commandUpTo(cmd, limit))
+	}
+	return truncate(b.String(), o.MaxPipelineLength)
+}
+
+// formatArg returns `arg` as sent to Redis with Masks replaced, cut to `max` bytes
+// unless `max` is negative. Without Masks, it's cut before it's formatted whole
+func (o StatementOptions) formatArg(arg interface{}, max int) string {
+	if len(o.Masks) == 0 {
+		return formatArg(arg, max)
+	}
+	s := formatArg(arg, -1)
+	for _, mask := range o.Masks {
+		s = mask.ReplaceAllString(s, redacted)
+	}
+	if max >= 0 && len(s) > max {
+		return s[:max]
+	}
+	return s
+}
+
+// formatArg returns `arg` as sent to Redis, cut to `max` bytes unless `max` is negative.
+// Strings and byte slices are cut before they're copied
+func formatArg(arg interface{}, max int) string {
+	var s string
+	switch v := arg.(type) {
+	case string:
+		s = v
+	case []byte:
+		if max >= 0 && len(v) > max {
+			v = v[:max]
+		}
+		return string(v)
+	default:
+		s = fmt.Sprint(arg)
+	}
+	if max >= 0 && len(s) > max {
+		return s[:max]
+	}
+	return s
+}
+
+// truncate cuts `s` to at most `max` bytes ending in "...", without splitting runes.
+// If `max` is shorter than "...", it's cut "...". It returns `s` if max isn't positive
+func truncate(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+	if max < len(truncated) {
+		return truncated[:max]
+	}
+	end := max - len(truncated)
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + truncated
+}
+
+// commands whose arguments are all keys
+var allKeysCommands = map[string]bool{
+	"del": true, "exists": true, "mget": true, "pfcount": true, "pfmerge": true,
+	"rename": true, "renamenx": true, "rpoplpush": true, "sdiff": true, "sdiffstore": true,
+	"sinter": true, "sinterstore": true, "sunion": true, "sunionstore": true,
+	"touch": true, "unlink": true, "watch": true,
+}
+
+// commands without keys, whose arguments are all values
+var noKeysCommands = map[string]bool{
+	"auth": true, "client": true, "cluster": true, "config": true, "echo": true,
+	"info": true, "ping": true, "script": true, "select": true,
+}
+
+// commandKeys returns whether the argument at each position of `args`, the arguments of
+// command `name` including it, is a key. Other commands are assumed to have a single key first
+func commandKeys(name string, args []interface{}) func(pos int) bool {
+	switch {
+	case allKeysCommands[name]:
+		return func(int) bool { return true }
+	case noKeysCommands[name]:
+		return func(int) bool { return false }
+	case name == "mset" || name == "msetnx":
+		return func(pos int) bool { return pos%2 == 1 }
+	case name == "blpop" || name == "brpop" || name == "brpoplpush":
+		// the timeout is last
+		return func(pos int) bool { return pos < len(args)-1 }
+	case name == "eval" || name == "evalsha":
+		// script, numkeys, keys..., args...
+		numKeys := 0
+		if len(args) > 2 {
+			fmt.Sscan(fmt.Sprint(args[2]), &numKeys)
+		}
+		return func(pos int) bool { return pos > 2 && pos <= 2+numKeys }
+	default:
+		return func(pos int) bool { return pos == 1 }
+	}
+}
@@ -0,0 +1,157 @@
+package redis_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestBaseClient_SetStatementOptions(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	tests := []struct {
+		name      string
+		opt       redis.StatementOptions
+		statement string
+	}{
+		{"none", redis.StatementOptions{}, "mset user:1 secret user:2 other"},
+		{"keys only", redis.StatementOptions{Redaction: redis.RedactValues}, "mset user:1 ? user:2 ?"},
+		{"command only", redis.StatementOptions{Redaction: redis.RedactArgs}, "mset"},
+		{"masked", redis.StatementOptions{
+			Redaction: redis.RedactValues,
+			Masks:     []*regexp.Regexp{regexp.MustCompile(`\d+`)},
+		}, "mset user:? ? user:? ?"},
+		{"truncated", redis.StatementOptions{MaxLength: 15}, "mset user:1 ..."},
+		{"shorter than the ellipsis", redis.StatementOptions{MaxLength: 2}, ".."},
+		{"command only shorter than the ellipsis", redis.StatementOptions{Redaction: redis.RedactArgs, MaxLength: 1}, "."},
+	}
+	for _, tt := range tests {
+		recorder.Reset()
+		client.SetStatementOptions(tt.opt)
+		assert.Nil(t, client.MSet("user:1", "secret", "user:2", "other").Err(), tt.name)
+		spans := recorder.Ended()
+		if assert.Len(t, spans, 1, tt.name) {
+			assert.Equal(t, tt.statement, spans[0].Attributes["db.statement"], tt.name)
+		}
+	}
+}
+
+func TestBaseClient_SetStatementOptions_Pipeline(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	client.SetStatementOptions(redis.StatementOptions{
+		Redaction:         redis.RedactValues,
+		MaxPipelineLength: 40,
+	})
+	_, err = client.Pipelined(func(pipe goredis.Pipeliner) error {
+		for i := 0; i < 10; i++ {
+			pipe.Set("key", strings.Repeat("value", 100), 0)
+		}
+		pipe.EvalSha("sha", []string{"key"}, "arg")
+		return nil
+	})
+	assert.NotNil(t, err)
+	spans := recorder.Ended()
+	statement := spans[len(spans)-1].Attributes["db.statement"].(string)
+	assert.Equal(t, "set key ?\nset key ?\nset key ?\nset key...", statement)
+	recorder.Reset()
+	client.SetStatementOptions(redis.StatementOptions{Redaction: redis.RedactValues})
+	client.EvalSha("sha", []string{"key"}, "arg")
+	spans = recorder.Ended()
+	assert.Equal(t, "evalsha ? ? key ?", spans[0].Attributes["db.statement"])
+}
+
+// formatSpy is an argument recording whether it was formatted
+type formatSpy struct {
+	formatted *bool
+}
+
+func (s formatSpy) String() string {
+	*s.formatted = true
+	return "spy"
+}
+
+func TestBaseClient_SetStatementOptions_LargeValues(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	client.SetStatementOptions(redis.StatementOptions{MaxLength: 20})
+	formatted := false
+	client.Do("set", "key", strings.Repeat("value", 1000), formatSpy{&formatted})
+	spans := recorder.Ended()
+	assert.Equal(t, "set key valuevalu...", spans[0].Attributes["db.statement"])
+	assert.False(t, formatted)
+
+	recorder.Reset()
+	client.SetStatementOptions(redis.StatementOptions{})
+	assert.Nil(t, client.Set("key", []byte("value"), 0).Err())
+	spans = recorder.Ended()
+	assert.Equal(t, "set key value", spans[0].Attributes["db.statement"])
+}
+
+func TestBaseClient_SetStatementOptions_MasksBeforeCutting(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	client.SetStatementOptions(redis.StatementOptions{
+		Masks:     []*regexp.Regexp{regexp.MustCompile(`\d{16}`)},
+		MaxLength: 22,
+	})
+	assert.Nil(t, client.Set("card", "4111111111111111", 0).Err())
+	spans := recorder.Ended()
+	// the card number cut to 10 digits would be left unmasked
+	assert.Equal(t, "set card ?", spans[0].Attributes["db.statement"])
+	recorder.Reset()
+	assert.Nil(t, client.Set("key", "card 4111111111111111 expires 12/30", 0).Err())
+	spans = recorder.Ended()
+	assert.Equal(t, "set key card ? expi...", spans[0].Attributes["db.statement"])
+}
+
+func TestBaseClient_SetStatementOptionsWhileRunning(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	c := client.WithContext(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			assert.Nil(t, c.Set("key", "value", 0).Err())
+		}
+	}()
+	client.SetStatementOptions(redis.StatementOptions{Redaction: redis.RedactArgs})
+	<-done
+	// copies returned before share them too
+	recorder.Reset()
+	assert.Nil(t, c.Set("key", "value", 0).Err())
+	spans := recorder.Ended()
+	assert.Equal(t, "set", spans[0].Attributes["db.statement"])
+}
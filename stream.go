@@ -0,0 +1,260 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+)
+
+// StreamHandler processes a message read from a stream by a StreamWorker.
+// Messages are acknowledged when it returns nil, otherwise they're kept
+// pending to be reclaimed later
+type StreamHandler func(ctx context.Context, msg goredis.XMessage) error
+
+// StreamWorkerOptions define available settings for a StreamWorker
+type StreamWorkerOptions struct {
+	// Stream is the key of the stream to read from
+	Stream string
+	// Group is the consumer group, created if it doesn't exist
+	Group string
+	// Consumer is the name of this worker in the consumer group
+	Consumer string
+	// Count is the max number of messages read at a time
+	// Default: 10
+	Count int64
+	// Block is how long a read waits for new messages
+	// Default: 1s
+	Block time.Duration
+	// ClaimMinIdle is how long a message must be pending, e.g. because its consumer
+	// died or its handler failed, before it's reclaimed by this worker
+	// Default: 30s
+	ClaimMinIdle time.Duration
+	// ClaimInterval is how often pending messages are checked for reclaiming
+	// Default: ClaimMinIdle
+	ClaimInterval time.Duration
+	// MaxDeliveries is how many times a message is delivered before it's given up on.
+	// A reclaimed message delivered this many times is acknowledged without being handled,
+	// after it's added to DeadLetterStream if set
+	// Default: 0, retry forever
+	MaxDeliveries int64
+	// DeadLetterStream is the key of the stream that messages given up on are added to
+	DeadLetterStream string
+}
+
+// Validate StreamWorkerOptions
+func (o StreamWorkerOptions) Validate() error {
+	if o.Stream == "" {
+		return wrapError(ErrInvalidOptions, errors.New("Stream is required"))
+	}
+	if o.Group == "" {
+		return wrapError(ErrInvalidOptions, errors.New("Group is required"))
+	}
+	if o.Consumer == "" {
+		return wrapError(ErrInvalidOptions, errors.New("Consumer is required"))
+	}
+	if o.MaxDeliveries < 0 {
+		return wrapError(ErrInvalidOptions, errors.New("MaxDeliveries can't be negative"))
+	}
+	return nil
+}
+
+// StreamWorker consumes a stream as part of a consumer group, handing each message
+// to a StreamHandler and reclaiming messages left pending by other consumers
+type StreamWorker struct {
+	client  Client
+	handler StreamHandler
+	opt     StreamWorkerOptions
+}
+
+// NewStreamWorker creates a StreamWorker reading through `client`, creating
+// the stream and the consumer group if they don't exist
+func NewStreamWorker(client Client, opt StreamWorkerOptions, handler StreamHandler) (*StreamWorker, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	if opt.Count == 0 {
+		opt.Count = 10
+	}
+	if opt.Block == 0 {
+		opt.Block = time.Second
+	}
+	if opt.ClaimMinIdle == 0 {
+		opt.ClaimMinIdle = 30 * time.Second
+	}
+	if opt.ClaimInterval == 0 {
+		opt.ClaimInterval = opt.ClaimMinIdle
+	}
+	err := client.XGroupCreateMkStream(opt.Stream, opt.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, err
+	}
+	return &StreamWorker{client: client, handler: handler, opt: opt}, nil
+}
+
+// Run consumes messages until `ctx` is done, when it returns nil after
+// handling the messages already read, `ctx` is also handed to the StreamHandler.
+// It starts with the messages left pending for this consumer, e.g. by a previous run that crashed
+func (w *StreamWorker) Run(ctx context.Context) error {
+	for id := "0"; id != "" && ctx.Err() == nil; {
+		var err error
+		if id, err = w.consume(ctx, id); err != nil {
+			return err
+		}
+	}
+	lastClaim := time.Now()
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= w.opt.ClaimInterval {
+			if err := w.reclaim(ctx); err != nil {
+				return err
+			}
+			lastClaim = time.Now()
+		}
+		if _, err := w.consume(ctx, ">"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consume reads messages after `id` and handles them, ">" reads new messages
+// and any other id reads messages pending for this consumer.
+// It returns the id of the last message read, or "" if there were none
+func (w *StreamWorker) consume(ctx context.Context, id string) (string, error) {
+	args := &goredis.XReadGroupArgs{
+		Group:    w.opt.Group,
+		Consumer: w.opt.Consumer,
+		Streams:  []string{w.opt.Stream, id},
+		Count:    w.opt.Count,
+	}
+	if id == ">" {
+		args.Block = w.opt.Block
+	}
+	streams, err := w.client.XReadGroup(args).Result()
+	if err == goredis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	last := ""
+	for _, stream := range streams {
+		if err := w.handle(ctx, stream.Messages); err != nil {
+			return "", err
+		}
+		if len(stream.Messages) > 0 {
+			last = stream.Messages[len(stream.Messages)-1].ID
+		}
+	}
+	return last, nil
+}
+
+// reclaim claims messages pending for longer than ClaimMinIdle and handles them,
+// paging through all the pending messages of the group Count at a time
+func (w *StreamWorker) reclaim(ctx context.Context) error {
+	for start := "-"; ctx.Err() == nil; {
+		pending, err := w.client.XPendingExt(&goredis.XPendingExtArgs{
+			Stream: w.opt.Stream,
+			Group:  w.opt.Group,
+			Start:  start,
+			End:    "+",
+			Count:  w.opt.Count,
+		}).Result()
+		if err != nil {
+			return err
+		}
+		if err := w.claim(ctx, pending); err != nil {
+			return err
+		}
+		if int64(len(pending)) < w.opt.Count {
+			return nil
+		}
+		start = nextStreamID(pending[len(pending)-1].Id)
+	}
+	return nil
+}
+
+// claim claims the `pending` messages idle for longer than ClaimMinIdle and handles them,
+// giving up on the ones already delivered MaxDeliveries times
+func (w *StreamWorker) claim(ctx context.Context, pending []goredis.XPendingExt) error {
+	ids := make([]string, 0, len(pending))
+	deliveries := make(map[string]int64, len(pending))
+	for _, p := range pending {
+		if p.Idle >= w.opt.ClaimMinIdle {
+			ids = append(ids, p.Id)
+			deliveries[p.Id] = p.RetryCount
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	msgs, err := w.client.XClaim(&goredis.XClaimArgs{
+		Stream:   w.opt.Stream,
+		Group:    w.opt.Group,
+		Consumer: w.opt.Consumer,
+		MinIdle:  w.opt.ClaimMinIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return err
+	}
+	retry := make([]goredis.XMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		if w.opt.MaxDeliveries > 0 && deliveries[msg.ID] >= w.opt.MaxDeliveries {
+			if err := w.giveUp(msg); err != nil {
+				return err
+			}
+			continue
+		}
+		retry = append(retry, msg)
+	}
+	return w.handle(ctx, retry)
+}
+
+// giveUp acknowledges `msg` without handling it, after adding it to DeadLetterStream if set
+func (w *StreamWorker) giveUp(msg goredis.XMessage) error {
+	if w.opt.DeadLetterStream != "" {
+		err := w.client.XAdd(&goredis.XAddArgs{Stream: w.opt.DeadLetterStream, Values: msg.Values}).Err()
+		if err != nil {
+			return err
+		}
+	}
+	return w.client.XAck(w.opt.Stream, w.opt.Group, msg.ID).Err()
+}
+
+// nextStreamID returns the smallest stream id greater than `id`
+func nextStreamID(id string) string {
+	ms, seq := id, "0"
+	if i := strings.IndexByte(id, '-'); i >= 0 {
+		ms, seq = id[:i], id[i+1:]
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return id
+	}
+	if n < math.MaxUint64 {
+		return ms + "-" + strconv.FormatUint(n+1, 10)
+	}
+	m, err := strconv.ParseUint(ms, 10, 64)
+	if err != nil {
+		return id
+	}
+	return strconv.FormatUint(m+1, 10) + "-0"
+}
+
+// handle runs the handler for each message, acknowledging the ones it succeeds
+func (w *StreamWorker) handle(ctx context.Context, msgs []goredis.XMessage) error {
+	for _, msg := range msgs {
+		if err := w.handler(ctx, msg); err != nil {
+			continue
+		}
+		if err := w.client.XAck(w.opt.Stream, w.opt.Group, msg.ID).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+func TestNewStreamWorker_InvalidOptions(t *testing.T) {
+	_, err := redis.NewStreamWorker(redis.NewErrClient(nil), redis.StreamWorkerOptions{}, nil)
+	assert.True(t, errors.Is(err, redis.ErrInvalidOptions))
+}
+
+func TestStreamWorker_Run(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	workerOpt := redis.StreamWorkerOptions{
+		Stream:   "some_stream",
+		Group:    "some_group",
+		Consumer: "some_consumer",
+		Block:    10 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var mutex sync.Mutex
+	var values []interface{}
+	worker, err := redis.NewStreamWorker(client, workerOpt, func(ctx context.Context, msg goredis.XMessage) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		values = append(values, msg.Values["n"])
+		if len(values) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	for _, n := range []string{"0", "1"} {
+		err := client.XAdd(&goredis.XAddArgs{Stream: "some_stream", Values: map[string]interface{}{"n": n}}).Err()
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, worker.Run(ctx))
+	assert.Equal(t, []interface{}{"0", "1"}, values)
+	pending, err := client.XPending("some_stream", "some_group").Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), pending.Count)
+}
+
+func TestStreamWorker_Reclaim(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	workerOpt := redis.StreamWorkerOptions{
+		Stream:        "some_stream",
+		Group:         "some_group",
+		Consumer:      "some_consumer",
+		Block:         10 * time.Millisecond,
+		ClaimMinIdle:  time.Millisecond,
+		ClaimInterval: time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var claimed []string
+	worker, err := redis.NewStreamWorker(client, workerOpt, func(ctx context.Context, msg goredis.XMessage) error {
+		claimed = append(claimed, msg.ID)
+		cancel()
+		return nil
+	})
+	assert.Nil(t, err)
+	id, err := client.XAdd(&goredis.XAddArgs{Stream: "some_stream", Values: map[string]interface{}{"n": "0"}}).Result()
+	assert.Nil(t, err)
+	// a consumer that reads the message and dies before acknowledging it
+	_, err = client.XReadGroup(&goredis.XReadGroupArgs{
+		Group:    "some_group",
+		Consumer: "dead_consumer",
+		Streams:  []string{"some_stream", ">"},
+	}).Result()
+	assert.Nil(t, err)
+	time.Sleep(5 * time.Millisecond)
+	assert.Nil(t, worker.Run(ctx))
+	assert.Equal(t, []string{id}, claimed)
+}
+
+func TestStreamWorker_RunPendingPages(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	workerOpt := redis.StreamWorkerOptions{
+		Stream:   "some_stream",
+		Group:    "some_group",
+		Consumer: "some_consumer",
+		Count:    1,
+		Block:    10 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var values []interface{}
+	worker, err := redis.NewStreamWorker(client, workerOpt, func(ctx context.Context, msg goredis.XMessage) error {
+		values = append(values, msg.Values["n"])
+		if len(values) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	for _, n := range []string{"0", "1", "2"} {
+		err := client.XAdd(&goredis.XAddArgs{Stream: "some_stream", Values: map[string]interface{}{"n": n}}).Err()
+		assert.Nil(t, err)
+	}
+	// a previous run of this consumer that read every message and crashed
+	_, err = client.XReadGroup(&goredis.XReadGroupArgs{
+		Group:    "some_group",
+		Consumer: "some_consumer",
+		Streams:  []string{"some_stream", ">"},
+	}).Result()
+	assert.Nil(t, err)
+	assert.Nil(t, worker.Run(ctx))
+	assert.Equal(t, []interface{}{"0", "1", "2"}, values)
+}
+
+func TestStreamWorker_ReclaimPagesAndGivesUp(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	assert.Nil(t, client.FlushAll().Err())
+	workerOpt := redis.StreamWorkerOptions{
+		Stream:           "some_stream",
+		Group:            "some_group",
+		Consumer:         "some_consumer",
+		Count:            1,
+		Block:            time.Millisecond,
+		ClaimMinIdle:     time.Millisecond,
+		ClaimInterval:    time.Millisecond,
+		MaxDeliveries:    2,
+		DeadLetterStream: "dead_stream",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var handled []interface{}
+	worker, err := redis.NewStreamWorker(client, workerOpt, func(ctx context.Context, msg goredis.XMessage) error {
+		if msg.Values["n"] == "poison" {
+			return errors.New("poison")
+		}
+		handled = append(handled, msg.Values["n"])
+		return nil
+	})
+	assert.Nil(t, err)
+	for _, n := range []string{"poison", "0", "1"} {
+		err := client.XAdd(&goredis.XAddArgs{Stream: "some_stream", Values: map[string]interface{}{"n": n}}).Err()
+		assert.Nil(t, err)
+	}
+	// a consumer that reads the messages and dies before acknowledging them
+	_, err = client.XReadGroup(&goredis.XReadGroupArgs{
+		Group:    "some_group",
+		Consumer: "dead_consumer",
+		Streams:  []string{"some_stream", ">"},
+	}).Result()
+	assert.Nil(t, err)
+	go func() {
+		for ctx.Err() == nil {
+			dead, err := client.XRange("dead_stream", "-", "+").Result()
+			if err == nil && len(dead) > 0 {
+				cancel()
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	assert.Nil(t, worker.Run(ctx))
+	assert.Equal(t, []interface{}{"0", "1"}, handled)
+	dead, err := client.XRange("dead_stream", "-", "+").Result()
+	assert.Nil(t, err)
+	if assert.Len(t, dead, 1) {
+		assert.Equal(t, "poison", dead[0].Values["n"])
+	}
+	pending, err := client.XPending("some_stream", "some_group").Result()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), pending.Count)
+}
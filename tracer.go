@@ -0,0 +1,137 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	tracing "github.com/topfreegames/go-extensions-tracing"
+)
+
+// Attributes of a span, keyed after the OpenTelemetry database semantic conventions,
+// e.g. db.system, db.operation, db.statement and net.peer.name
+type Attributes map[string]interface{}
+
+// Tracer starts the spans of commands, pipelines, transactions, locks, scripts and
+// subscriptions. OpenTracingTracer is the default, see SetTracer
+type Tracer interface {
+	// StartSpan starts a client span called `name`, child of the span in `ctx` if any,
+	// returning a copy of `ctx` with the new span
+	StartSpan(ctx context.Context, name string, attrs Attributes) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	SetAttribute(key string, value interface{})
+	// RecordError marks the span as failed by `err`
+	RecordError(err error)
+	End()
+}
+
+var (
+	tracerMu sync.RWMutex
+	tracer   Tracer = OpenTracingTracer{}
+)
+
+// SetTracer sets the Tracer used by all clients, nil restores the default OpenTracingTracer
+func SetTracer(t Tracer) {
+	tracerMu.Lock()
+	defer tracerMu.Unlock()
+	if t == nil {
+		t = OpenTracingTracer{}
+	}
+	tracer = t
+}
+
+func getTracer() Tracer {
+	tracerMu.RLock()
+	defer tracerMu.RUnlock()
+	return tracer
+}
+
+// dbAttributes returns the semantic convention attributes of the Redis instance
+// at `addr` using `db`. Addresses that aren't host:port, e.g. cluster seeds or
+// failover master names, are used as net.peer.name as they are
+func dbAttributes(addr string, db int) Attributes {
+	attrs := Attributes{
+		"db.system":               "redis",
+		"db.redis.database_index": db,
+		"net.peer.name":           addr,
+	}
+	if host, port, err := net.SplitHostPort(addr); err == nil {
+		attrs["net.peer.name"] = host
+		if p, err := strconv.Atoi(port); err == nil {
+			attrs["net.peer.port"] = p
+		}
+	}
+	return attrs
+}
+
+// OpenTracingTracer is a Tracer starting spans on an opentracing.Tracer. Attributes are
+// renamed to the OpenTracing semantic conventions: db.system to db.type,
+// db.redis.database_index to db.instance, net.peer.name to peer.hostname and
+// net.peer.port to peer.port
+type OpenTracingTracer struct {
+	// Tracer starting the spans, opentracing.GlobalTracer() if nil
+	Tracer opentracing.Tracer
+}
+
+var openTracingTags = map[string]string{
+	"db.system":               "db.type",
+	"db.redis.database_index": "db.instance",
+	"net.peer.name":           "peer.hostname",
+	"net.peer.port":           "peer.port",
+}
+
+// StartSpan implements Tracer
+func (t OpenTracingTracer) StartSpan(ctx context.Context, name string, attrs Attributes) (context.Context, Span) {
+	ot := t.Tracer
+	if ot == nil {
+		ot = opentracing.GlobalTracer()
+	}
+	var parent opentracing.SpanContext
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		parent = span.Context()
+	}
+	tags := opentracing.Tags{"span.kind": "client"}
+	for key, value := range attrs {
+		tags[openTracingTag(key)] = value
+	}
+	span := ot.StartSpan(name, opentracing.ChildOf(parent), tags)
+	return opentracing.ContextWithSpan(ctx, span), openTracingSpan{span}
+}
+
+func openTracingTag(key string) string {
+	if tag, ok := openTracingTags[key]; ok {
+		return tag
+	}
+	return key
+}
+
+type openTracingSpan struct {
+	span opentracing.Span
+}
+
+func (s openTracingSpan) SetAttribute(key string, value interface{}) {
+	s.span.SetTag(openTracingTag(key), value)
+}
+
+func (s openTracingSpan) RecordError(err error) {
+	tracing.LogError(s.span, err.Error())
+}
+
+func (s openTracingSpan) End() {
+	s.span.Finish()
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+
+func (noopSpan) RecordError(error) {}
+
+func (noopSpan) End() {}
+
+var _ Tracer = OpenTracingTracer{}
@@ -0,0 +1,130 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// useTracer sets `tracer` as the Tracer of all clients until restore is called
+func useTracer(tracer redis.Tracer) (restore func()) {
+	redis.SetTracer(tracer)
+	return func() { redis.SetTracer(nil) }
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSpanRecorder(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666/2")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := redis.NewSpanRecorder()
+	defer useTracer(recorder)()
+	ctx, parent := recorder.StartSpan(context.Background(), "parent", nil)
+	c := client.WithContext(ctx)
+	assert.Nil(t, c.Set("key", "value", 0).Err())
+	lock, err := c.(redis.Locker).Obtain("lock", time.Second, redis.LockOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+	parent.End()
+	spans := recorder.Ended()
+	assert.Equal(t, "redis set", spans[0].Name)
+	assert.Equal(t, redis.Attributes{
+		"db.system":               "redis",
+		"db.operation":            "set",
+		"db.statement":            "set key value",
+		"db.redis.database_index": 2,
+		"net.peer.name":           "localhost",
+		"net.peer.port":           6666,
+	}, spans[0].Attributes)
+	root := spans[len(spans)-1]
+	assert.Equal(t, "parent", root.Name)
+	names := make([]string, 0, len(spans))
+	lockSpans := []string{"redis obtain lock", "redis release lock"}
+	assert.Equal(t, root, spans[0].Parent)
+	for _, span := range spans[1 : len(spans)-1] {
+		names = append(names, span.Name)
+		if contains(lockSpans, span.Name) {
+			assert.Equal(t, root, span.Parent, span.Name)
+			continue
+		}
+		// the commands of a lock are children of its span
+		if assert.NotNil(t, span.Parent, span.Name) {
+			assert.Contains(t, lockSpans, span.Parent.Name, span.Name)
+			assert.Equal(t, root, span.Parent.Parent, span.Name)
+		}
+	}
+	assert.Contains(t, names, "redis obtain lock")
+	assert.Contains(t, names, "redis release lock")
+	recorder.Reset()
+	assert.NotNil(t, client.Incr("key").Err())
+	spans = recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.NotNil(t, spans[0].Err)
+	// a missing key isn't an error
+	recorder.Reset()
+	assert.Equal(t, goredis.Nil, client.Get("missing_key").Err())
+	spans = recorder.Ended()
+	assert.Len(t, spans, 1)
+	assert.Nil(t, spans[0].Err)
+}
+
+func TestOpenTracingTracer_Tags(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	tracer, restore := useMockTracer()
+	defer restore()
+	assert.Nil(t, client.Set("key", "value", 0).Err())
+	spans := tracer.FinishedSpans()
+	assert.Len(t, spans, 1)
+	tags := spans[0].Tags()
+	assert.Equal(t, "redis", tags["db.type"])
+	assert.Equal(t, 0, tags["db.instance"])
+	assert.Equal(t, "localhost", tags["peer.hostname"])
+	assert.Equal(t, "client", tags["span.kind"])
+}
+
+func TestOpenTelemetryTracer(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	assert.Nil(t, err)
+	opt.DialTimeout = 20 * time.Millisecond
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer useTracer(redis.OpenTelemetryTracer{Tracer: provider.Tracer("test")})()
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, err = client.WithContext(ctx).Pipelined(func(pipe goredis.Pipeliner) error {
+		pipe.Set("key", "value", 0)
+		return nil
+	})
+	assert.Nil(t, err)
+	parent.End()
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "redis pipe", spans[0].Name())
+	assert.Equal(t, oteltrace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("db.system", "redis"))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("net.peer.port", 6666))
+}
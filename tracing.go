@@ -3,30 +3,124 @@ package redis
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	goredis "github.com/go-redis/redis"
-	"github.com/opentracing/opentracing-go"
-	tracing "github.com/topfreegames/go-extensions-tracing"
 )
 
-// instrument adds open tracing instrumentation on a *client
-func instrument(client *BaseClient) {
-	client.Client.WrapProcess(makeMiddleware(client.Client))
-	client.Client.WrapProcessPipeline(makeMiddlewarePipe(client.Client))
+// processWrapper is a go-redis client whose command and pipeline processing can be wrapped,
+// i.e. *goredis.Client or *goredis.ClusterClient
+type processWrapper interface {
+	Context() context.Context
+	WrapProcess(fn func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error)
+	WrapProcessPipeline(fn func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error)
+}
+
+// instrumentation keeps the uninstrumented process functions of an instrumented client,
+// so its WithContext copies replace its middlewares, instead of stacking on them
+type instrumentation struct {
+	addr       string
+	db         int
+	metrics    atomic.Value // commandMetricsValue
+	statements atomic.Value // StatementOptions
+	process    func(cmd goredis.Cmder) error
+	pipelines  []func(cmds []goredis.Cmder) error
+}
+
+// instrument adds tracing and metrics instrumentation on a client to `addr` using `db`,
+// tracing its commands, pipelines and transactions under its context
+func instrument(client processWrapper, addr string, db int) *instrumentation {
+	in := &instrumentation{addr: addr, db: db}
+	client.WrapProcess(func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+		in.process = old
+		return in.middleware(client.Context())(old)
+	})
+	client.WrapProcessPipeline(func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+		in.pipelines = append(in.pipelines, old)
+		return in.middlewarePipe(client.Context())(old)
+	})
+	return in
+}
+
+// withContext instruments `client`, a WithContext copy of the instrumented client,
+// to trace under `ctx` instead of the context of the instrumented client
+func (in *instrumentation) withContext(client processWrapper, ctx context.Context) {
+	client.WrapProcess(func(func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+		return in.middleware(ctx)(in.process)
+	})
+	// pipelines are wrapped in the same order they were when instrumented
+	next := 0
+	client.WrapProcessPipeline(func(func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+		process := in.pipelines[next]
+		next++
+		return in.middlewarePipe(ctx)(process)
+	})
+}
+
+// processContext runs `cmd` on the instrumented client traced under `ctx`,
+// instead of under the context of the client
+func (in *instrumentation) processContext(ctx context.Context, cmd goredis.Cmder) error {
+	return in.middleware(ctx)(in.process)(cmd)
+}
+
+// processPipelineContext runs `cmds` on the instrumented client as a pipeline,
+// or as a transaction if `tx`, traced under `ctx`
+func (in *instrumentation) processPipelineContext(ctx context.Context, cmds []goredis.Cmder, tx bool) error {
+	// pipelines are wrapped before transactions
+	process := in.pipelines[0]
+	if tx {
+		process = in.pipelines[1]
+	}
+	return in.middlewarePipe(ctx)(process)(cmds)
+}
+
+// instrumentTx adds the instrumentation on a transaction, which
+// go-redis creates uninstrumented, tracing under `ctx`
+func (in *instrumentation) instrumentTx(tx *goredis.Tx, ctx context.Context) {
+	tx.WrapProcess(in.middleware(ctx))
+	tx.WrapProcessPipeline(in.middlewarePipe(ctx))
+}
+
+func (in *instrumentation) middleware(
+	ctx context.Context,
+) func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+	return func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
+		return makeMiddleware(ctx, in.addr, in.db, in.statementOptions)(makeMetricsMiddleware(in.getMetrics, in.addr, in.db)(old))
+	}
+}
+
+func (in *instrumentation) middlewarePipe(
+	ctx context.Context,
+) func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+	return func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
+		return makeMiddlewarePipe(ctx, in.addr, in.db, in.statementOptions)(makeMetricsMiddlewarePipe(in.getMetrics, in.addr, in.db)(old))
+	}
+}
+
+func (in *instrumentation) getMetrics() CommandMetrics {
+	if v, ok := in.metrics.Load().(commandMetricsValue); ok && v.metrics != nil {
+		return v.metrics
+	}
+	return noopCommandMetrics{}
+}
+
+func (in *instrumentation) statementOptions() StatementOptions {
+	opt, _ := in.statements.Load().(StatementOptions)
+	return opt
 }
 
 func makeMiddleware(
-	client *goredis.Client,
+	ctx context.Context,
+	addr string,
+	db int,
+	statements func() StatementOptions,
 ) func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
 	return func(old func(cmd goredis.Cmder) error) func(cmd goredis.Cmder) error {
 		return func(cmd goredis.Cmder) error {
-			tags := opentracing.Tags{
-				"db.instance":  client.Options().DB,
-				"db.statement": parseLong(cmd),
-				"db.type":      "redis",
-				"span.kind":    "client",
-			}
-			return trace(client.Context(), fmt.Sprintf("redis %s", cmd.Name()), tags, func() error {
+			attrs := dbAttributes(addr, db)
+			attrs["db.operation"] = cmd.Name()
+			attrs["db.statement"] = statements().command(cmd)
+			return trace(ctx, fmt.Sprintf("redis %s", cmd.Name()), attrs, func() error {
 				return old(cmd)
 			})
 		}
@@ -34,55 +128,49 @@ func makeMiddleware(
 }
 
 func makeMiddlewarePipe(
-	client *goredis.Client,
+	ctx context.Context,
+	addr string,
+	db int,
+	statements func() StatementOptions,
 ) func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
 	return func(old func(cmds []goredis.Cmder) error) func(cmds []goredis.Cmder) error {
 		return func(cmds []goredis.Cmder) error {
-			statement := ""
-			for idx, cmd := range cmds {
-				if idx > 0 {
-					statement = statement + "\n" + parseLong(cmd)
-				} else {
-					statement = parseLong(cmd)
-				}
-			}
-			tags := opentracing.Tags{
-				"db.instance":  client.Options().DB,
-				"db.statement": statement,
-				"db.type":      "redis",
-				"span.kind":    "client",
-			}
-			return trace(client.Context(), "redis pipe", tags, func() error {
+			attrs := dbAttributes(addr, db)
+			attrs["db.statement"] = statements().pipeline(cmds)
+			return trace(ctx, "redis pipe", attrs, func() error {
 				return old(cmds)
 			})
 		}
 	}
 }
 
-func trace(ctx context.Context, operationName string, tags opentracing.Tags, f func() error) error {
-	if ctx == nil {
+func trace(ctx context.Context, operationName string, attrs Attributes, f func() error) error {
+	return traceSpan(ctx, operationName, attrs, func(context.Context, Span) error {
 		return f()
+	})
+}
+
+// traceSpan is like trace but hands the span and its context to `f`, so it can add
+// attributes to it and trace its commands as children of it.
+// If ctx is nil `f` receives a nil context and a no-op span
+func traceSpan(ctx context.Context, operationName string, attrs Attributes, f func(context.Context, Span) error) error {
+	if ctx == nil {
+		return f(nil, noopSpan{})
 	}
-	var parent opentracing.SpanContext
-	if span := opentracing.SpanFromContext(ctx); span != nil {
-		parent = span.Context()
-	}
-	reference := opentracing.ChildOf(parent)
-	span := opentracing.StartSpan(operationName, reference, tags)
-	defer span.Finish()
-	defer tracing.LogPanic(span)
-	err := f()
-	if err != nil {
-		message := err.Error()
-		tracing.LogError(span, message)
+	ctx, span := getTracer().StartSpan(ctx, operationName, attrs)
+	defer span.End()
+	defer logPanic(span)
+	err := f(ctx, span)
+	if recorded := observedError(err); recorded != nil {
+		span.RecordError(recorded)
 	}
 	return err
 }
 
-func parseLong(cmd goredis.Cmder) string {
-	stmt := cmd.Name()
-	for _, arg := range cmd.Args() {
-		stmt = fmt.Sprintf("%s %v", stmt, arg)
+// logPanic records a panic on `span` and panics again
+func logPanic(span Span) {
+	if r := recover(); r != nil {
+		span.RecordError(fmt.Errorf("%v", r))
+		panic(r)
 	}
-	return stmt
 }
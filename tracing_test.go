@@ -0,0 +1,87 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	redis "github.com/topfreegames/go-extensions-redis"
+)
+
+// useMockTracer sets a *mocktracer.MockTracer as the global tracer until restore is called
+func useMockTracer() (tracer *mocktracer.MockTracer, restore func()) {
+	tracer = mocktracer.New()
+	previous := opentracing.GlobalTracer()
+	opentracing.SetGlobalTracer(tracer)
+	return tracer, func() { opentracing.SetGlobalTracer(previous) }
+}
+
+func operationNames(spans []*mocktracer.MockSpan) []string {
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.OperationName)
+	}
+	return names
+}
+
+func TestNewClient_Instrumented(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	tracer, restore := useMockTracer()
+	defer restore()
+	assert.Nil(t, client.Set("key", "value", 0).Err())
+	_, err = client.Pipelined(func(pipe goredis.Pipeliner) error {
+		pipe.Get("key")
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, client.Watch(func(tx *goredis.Tx) error {
+		return tx.Get("key").Err()
+	}, "key"))
+	assert.Equal(t, []string{"redis set", "redis pipe", "redis get", "redis unwatch"}, operationNames(tracer.FinishedSpans()))
+}
+
+func TestWithContext_TracesUnderContext(t *testing.T) {
+	opt, err := goredis.ParseURL("redis://localhost:6666")
+	opt.DialTimeout = 20 * time.Millisecond
+	assert.Nil(t, err)
+	client, err := redis.NewClient(opt)
+	assert.Nil(t, err)
+	tracer, restore := useMockTracer()
+	defer restore()
+	parent := tracer.StartSpan("parent")
+	ctx := opentracing.ContextWithSpan(context.Background(), parent)
+	c := client.WithContext(ctx).WithContext(ctx)
+	assert.Nil(t, c.Set("key", "value", 0).Err())
+	// one span per command, even after many WithContext
+	assert.Equal(t, []string{"redis set"}, operationNames(tracer.FinishedSpans()))
+	lock, err := c.(redis.Locker).Obtain("lock", time.Second, redis.LockOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, lock.Release())
+	spans := tracer.FinishedSpans()
+	parentID := parent.Context().(mocktracer.MockSpanContext).SpanID
+	lockSpans := map[int]string{}
+	for _, span := range spans {
+		if span.OperationName == "redis obtain lock" || span.OperationName == "redis release lock" {
+			lockSpans[span.SpanContext.SpanID] = span.OperationName
+		}
+	}
+	assert.Equal(t, parentID, spans[0].ParentID)
+	for _, span := range spans[1:] {
+		if _, ok := lockSpans[span.SpanContext.SpanID]; ok {
+			assert.Equal(t, parentID, span.ParentID, span.OperationName)
+			continue
+		}
+		// the commands of a lock are children of its span
+		assert.Contains(t, lockSpans, span.ParentID, span.OperationName)
+	}
+	assert.Contains(t, operationNames(spans), "redis obtain lock")
+	assert.Contains(t, operationNames(spans), "redis evalsha")
+}